@@ -0,0 +1,14 @@
+data "dokploy_project" "shared" {
+  name = "Shared Services"
+}
+
+data "dokploy_environment" "production" {
+  project_id = data.dokploy_project.shared.id
+  name       = "production"
+}
+
+data "dokploy_application" "api" {
+  project_id     = data.dokploy_project.shared.id
+  environment_id = data.dokploy_environment.production.id
+  name           = "api"
+}
@@ -0,0 +1,6 @@
+data "dokploy_database" "main" {
+  project_id     = var.project_id
+  environment_id = var.environment_id
+  type           = "postgres"
+  name           = "main-db"
+}
@@ -0,0 +1,3 @@
+data "dokploy_project" "shared" {
+  name = "Shared Services"
+}
@@ -0,0 +1,22 @@
+resource "dokploy_database" "main" {
+  project_id          = var.project_id
+  environment_id      = var.environment_id
+  name                = "main-db"
+  type                = "postgres"
+  password_wo         = var.database_password
+  password_wo_version = 1
+}
+
+ephemeral "dokploy_database_credentials" "main" {
+  database_id = dokploy_database.main.id
+  type        = dokploy_database.main.type
+}
+
+# Pass the connection string to the application without storing it in state.
+resource "dokploy_environment_variables" "app" {
+  application_id = var.application_id
+  variables_wo = {
+    DATABASE_URL = ephemeral.dokploy_database_credentials.main.connection_string
+  }
+  variables_wo_version = 1
+}
@@ -1,4 +1,18 @@
 provider "dokploy" {
   host    = "https://your-dokploy.com/api"
   api_key = "your-api-key"
+
+  # Optional: allow slow API calls more time than the 30s default.
+  request_timeout = "2m"
+}
+
+# Alternatively, leave the provider block empty and export DOKPLOY_HOST and
+# DOKPLOY_API_KEY, or select a profile from ~/.config/dokploy/credentials:
+#
+#   [staging]
+#   host    = https://staging.your-dokploy.com/api
+#   api_key = your-staging-api-key
+provider "dokploy" {
+  alias   = "staging"
+  profile = "staging"
 }
@@ -0,0 +1,69 @@
+resource "dokploy_application" "api" {
+  project_id     = dokploy_project.main.id
+  environment_id = dokploy_environment.production.id
+  name           = "api"
+  source_type    = "docker"
+  docker_image   = "ghcr.io/acme/api:1.4.2"
+
+  replicas = 3
+  mode     = "replicated"
+
+  resources = {
+    memory_reservation = 268435456  # 256 MiB
+    memory_limit       = 536870912  # 512 MiB
+    cpu_limit          = 1000000000 # 1 CPU
+  }
+
+  health_check = {
+    test         = ["CMD-SHELL", "curl -fsS http://localhost:8080/healthz || exit 1"]
+    interval     = "30s"
+    timeout      = "5s"
+    start_period = "20s"
+    retries      = 3
+  }
+
+  restart_policy = {
+    condition    = "on-failure"
+    delay        = "5s"
+    max_attempts = 5
+  }
+
+  placement = {
+    constraints = ["node.role==worker"]
+  }
+
+  update_config = {
+    parallelism    = 1
+    delay          = "10s"
+    failure_action = "rollback"
+    order          = "start-first"
+  }
+}
+
+resource "dokploy_application" "web" {
+  project_id     = dokploy_project.main.id
+  environment_id = dokploy_environment.production.id
+  name           = "web"
+  custom_git_url = "https://github.com/acme/web.git"
+
+  build_type        = "static"
+  publish_directory = "dist"
+  is_static_spa     = true
+
+  build_args = {
+    VITE_API_URL = "https://api.example.com"
+  }
+}
+
+resource "dokploy_application" "worker" {
+  project_id     = dokploy_project.main.id
+  environment_id = dokploy_environment.production.id
+  name           = "worker"
+  custom_git_url = "https://github.com/acme/worker.git"
+  build_type     = "dockerfile"
+
+  # Available to RUN --mount=type=secret,id=NPM_TOKEN in the Dockerfile.
+  build_secrets = {
+    NPM_TOKEN = var.npm_token
+  }
+}
@@ -0,0 +1,2 @@
+# Basic auth credentials can be imported using application_id/basic_auth_id
+terraform import dokploy_basic_auth.dashboard "app-id-123/security-id-456"
@@ -0,0 +1,12 @@
+variable "dashboard_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "dokploy_basic_auth" "dashboard" {
+  application_id      = dokploy_application.dashboard.id
+  username            = "ops"
+  password_wo         = var.dashboard_password
+  password_wo_version = 1
+}
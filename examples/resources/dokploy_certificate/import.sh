@@ -0,0 +1,2 @@
+# Certificates can be imported using their ID
+terraform import dokploy_certificate.wildcard "certificate-id-123"
@@ -0,0 +1,22 @@
+resource "dokploy_certificate" "wildcard" {
+  name             = "example-com-wildcard"
+  certificate_data = file("${path.module}/certs/example.com.fullchain.pem")
+  private_key      = file("${path.module}/certs/example.com.key.pem")
+}
+
+# Traefik serves the uploaded certificate for matching hosts.
+resource "dokploy_domain" "app" {
+  application_id = dokploy_application.web.id
+  host           = "app.example.com"
+  https          = true
+  certificate_id = dokploy_certificate.wildcard.id
+}
+
+# Alternatively, use a certificate resolver configured in Traefik.
+resource "dokploy_domain" "api" {
+  application_id       = dokploy_application.api.id
+  host                 = "api.example.com"
+  https                = true
+  certificate_provider = "custom"
+  custom_cert_resolver = "cloudflare"
+}
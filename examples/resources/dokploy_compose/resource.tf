@@ -0,0 +1,24 @@
+resource "dokploy_compose" "stack" {
+  project_id           = dokploy_project.main.id
+  environment_id       = dokploy_environment.production.id
+  name                 = "stack"
+  compose_file_content = file("${path.module}/docker-compose.yml")
+  deploy_on_create     = true
+
+  # Block until the stack is deployed and running before dependent
+  # resources (DNS records, smoke tests, ...) are applied.
+  wait_for_deployment {
+    timeout       = "15m"
+    poll_interval = "10s"
+    fail_on_error = true
+  }
+}
+
+# Park the staging stack overnight by switching desired_state to "stopped".
+resource "dokploy_compose" "staging" {
+  project_id           = dokploy_project.main.id
+  environment_id       = dokploy_environment.staging.id
+  name                 = "staging"
+  compose_file_content = file("${path.module}/docker-compose.yml")
+  desired_state        = var.staging_parked ? "stopped" : "running"
+}
@@ -0,0 +1,23 @@
+resource "dokploy_database" "main" {
+  project_id     = var.project_id
+  environment_id = var.environment_id
+  name           = "main-db"
+  type           = "postgres"
+  version        = "16"
+
+  database_name       = "app"
+  database_user       = "app"
+  password_wo         = var.database_password
+  password_wo_version = 1
+
+  command = "postgres -c max_connections=200"
+  env = {
+    TZ = "Europe/Berlin"
+  }
+  resources = {
+    memory_limit = 1073741824
+    cpu_limit    = 1000000000
+  }
+  external_port = 5433
+  desired_state = "running"
+}
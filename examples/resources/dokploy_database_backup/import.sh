@@ -0,0 +1,2 @@
+# Database backups can be imported using their ID
+terraform import dokploy_database_backup.shop_nightly "backup-id-123"
@@ -0,0 +1,15 @@
+resource "dokploy_database" "shop" {
+  project_id     = dokploy_project.main.id
+  environment_id = dokploy_environment.production.id
+  name           = "shop"
+  type           = "postgres"
+  password       = var.shop_db_password
+}
+
+resource "dokploy_database_backup" "shop_nightly" {
+  database_id       = dokploy_database.shop.id
+  database_type     = dokploy_database.shop.type
+  destination_name  = "s3-backups"
+  cron_expression   = "0 2 * * *"
+  keep_latest_count = 7
+}
@@ -0,0 +1,15 @@
+resource "dokploy_deployment" "api" {
+  application_id = dokploy_application.api.id
+
+  triggers = {
+    image_tag = var.api_image_tag
+  }
+
+  timeouts {
+    create = "45m"
+  }
+}
+
+output "api_deployment_status" {
+  value = dokploy_deployment.api.status
+}
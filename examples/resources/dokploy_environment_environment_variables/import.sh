@@ -0,0 +1,2 @@
+# Environment shared variables can be imported using the environment ID
+terraform import dokploy_environment_environment_variables.staging "environment-id-123"
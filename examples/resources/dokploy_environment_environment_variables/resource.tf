@@ -0,0 +1,18 @@
+resource "dokploy_environment_environment_variables" "staging" {
+  environment_id = dokploy_environment.staging.id
+
+  variables = {
+    API_BASE_URL = "https://staging-api.example.com"
+    LOG_LEVEL    = "debug"
+  }
+}
+
+# Services of the environment reference the shared variables.
+resource "dokploy_environment_variables" "api" {
+  application_id = dokploy_application.api.id
+
+  variables = {
+    API_BASE_URL = "$${{environment.API_BASE_URL}}"
+    LOG_LEVEL    = "$${{environment.LOG_LEVEL}}"
+  }
+}
@@ -0,0 +1,2 @@
+# Mounts can be imported using service_type/service_id/mount_id
+terraform import dokploy_mount.uploads "application/app-id-123/mount-id-456"
@@ -0,0 +1,27 @@
+resource "dokploy_mount" "uploads" {
+  service_type = "application"
+  service_id   = dokploy_application.api.id
+  mount_type   = "volume"
+  volume_name  = "uploads"
+  mount_path   = "/app/uploads"
+}
+
+resource "dokploy_mount" "backups" {
+  service_type = "postgres"
+  service_id   = dokploy_database.main.id
+  mount_type   = "bind"
+  host_path    = "/srv/backups"
+  mount_path   = "/backups"
+}
+
+resource "dokploy_mount" "mysql_config" {
+  service_type = "mysql"
+  service_id   = dokploy_database.reporting.id
+  mount_type   = "file"
+  file_path    = "my.cnf"
+  mount_path   = "/etc/mysql/conf.d/my.cnf"
+  content      = <<-EOT
+    [mysqld]
+    max_connections = 200
+  EOT
+}
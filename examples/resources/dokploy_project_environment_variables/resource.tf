@@ -11,3 +11,13 @@ resource "dokploy_project_environment_variables" "example" {
     LOG_LEVEL    = "info"
   }
 }
+
+# Only manage the keys below and leave variables added in the Dokploy UI alone.
+resource "dokploy_project_environment_variables" "shared" {
+  project_id    = dokploy_project.example.id
+  authoritative = false
+
+  variables = {
+    SENTRY_DSN = "https://key@sentry.example.com/1"
+  }
+}
@@ -0,0 +1,2 @@
+# Redirects can be imported using application_id/redirect_id
+terraform import dokploy_redirect.www_to_apex "app-id-123/redirect-id-456"
@@ -0,0 +1,18 @@
+resource "dokploy_domain" "www" {
+  application_id = dokploy_application.web.id
+  host           = "www.example.com"
+  https          = true
+}
+
+resource "dokploy_redirect" "www_to_apex" {
+  application_id = dokploy_application.web.id
+  regex          = "^https?://www\\.example\\.com/(.*)"
+  replacement    = "https://example.com/$${1}"
+  permanent      = true
+}
+
+resource "dokploy_redirect" "legacy_blog" {
+  application_id = dokploy_application.web.id
+  regex          = "^https://example\\.com/blog/(.*)"
+  replacement    = "https://blog.example.com/$${1}"
+}
@@ -0,0 +1,2 @@
+# Registries can be imported using their ID
+terraform import dokploy_registry.ghcr "registry-id-123"
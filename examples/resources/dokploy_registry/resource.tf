@@ -0,0 +1,18 @@
+resource "dokploy_registry" "ghcr" {
+  name          = "ghcr"
+  registry_url  = "ghcr.io"
+  username      = "deploy-bot"
+  password      = var.ghcr_token
+  image_prefix  = "acme"
+  test_on_apply = true
+}
+
+# Pull a private image with the registry's credentials.
+resource "dokploy_application" "api" {
+  project_id     = dokploy_project.main.id
+  environment_id = dokploy_environment.production.id
+  name           = "api"
+  source_type    = "docker"
+  docker_image   = "ghcr.io/acme/api:1.4.2"
+  registry_id    = dokploy_registry.ghcr.id
+}
@@ -0,0 +1,2 @@
+# Servers can be imported using their ID
+terraform import dokploy_server.worker_1 "server-id-123"
@@ -0,0 +1,25 @@
+resource "dokploy_ssh_key" "workers" {
+  name        = "workers"
+  private_key = file("~/.ssh/dokploy_workers")
+  public_key  = file("~/.ssh/dokploy_workers.pub")
+}
+
+resource "dokploy_server" "worker_1" {
+  name            = "worker-1"
+  ip_address      = "203.0.113.10"
+  ssh_key_id      = dokploy_ssh_key.workers.id
+  setup_on_create = true
+
+  timeouts {
+    create = "45m"
+  }
+}
+
+# Pin a workload to the remote server.
+resource "dokploy_compose" "queue" {
+  project_id           = dokploy_project.main.id
+  environment_id       = dokploy_environment.production.id
+  name                 = "queue"
+  server_id            = dokploy_server.worker_1.id
+  compose_file_content = file("${path.module}/queue.compose.yml")
+}
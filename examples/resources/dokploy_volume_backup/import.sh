@@ -0,0 +1,2 @@
+# Volume backups can be imported using their ID
+terraform import dokploy_volume_backup.api_uploads "volume-backup-id-123"
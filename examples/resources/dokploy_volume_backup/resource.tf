@@ -0,0 +1,18 @@
+# Back up a named volume of a compose service.
+resource "dokploy_volume_backup" "ghost_content" {
+  name             = "ghost-content"
+  compose_id       = dokploy_compose.ghost.id
+  service_name     = "ghost"
+  volume_name      = "ghost-content-data"
+  destination_name = "s3-backups"
+}
+
+# Back up a named volume mounted into an application.
+resource "dokploy_volume_backup" "api_uploads" {
+  name              = "api-uploads"
+  application_id    = dokploy_application.api.id
+  volume_name       = "api-uploads"
+  destination_name  = "s3-backups"
+  cron_expression   = "30 2 * * *"
+  keep_latest_count = 7
+}
@@ -2,13 +2,22 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
+	"net"
 	"net/http"
 	"net/url"
+	"reflect"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/net/websocket"
 )
 
 // DokployClient holds connection details.
@@ -16,85 +25,537 @@ type DokployClient struct {
 	BaseURL    string
 	APIKey     string
 	HTTPClient *http.Client
+	// MaxRetries is how many times a retryable request is re-sent before
+	// its error is returned. Zero disables retries.
+	MaxRetries int
+	// RetryMinWait and RetryMaxWait bound the exponential backoff between
+	// attempts. RetryMaxWait also caps server supplied Retry-After values.
+	RetryMinWait time.Duration
+	RetryMaxWait time.Duration
 }
 
+const (
+	DefaultMaxRetries   = 4
+	DefaultRetryMinWait = 250 * time.Millisecond
+	DefaultRetryMaxWait = 30 * time.Second
+	// DefaultRequestTimeout bounds a single HTTP attempt. Whole operations are
+	// bounded by the context passed to each method.
+	DefaultRequestTimeout = 30 * time.Second
+)
+
 func NewDokployClient(baseURL, apiKey string) *DokployClient {
 	return &DokployClient{
 		BaseURL: baseURL,
 		APIKey:  apiKey,
 		HTTPClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: DefaultRequestTimeout,
 		},
+		MaxRetries:   DefaultMaxRetries,
+		RetryMinWait: DefaultRetryMinWait,
+		RetryMaxWait: DefaultRetryMaxWait,
 	}
 }
 
-func (c *DokployClient) doRequest(method, endpoint string, body interface{}) ([]byte, error) {
-	var reqBody io.Reader
+func (c *DokployClient) doRequest(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
+	var payload []byte
 	if body != nil {
 		jsonBytes, err := json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
-		reqBody = bytes.NewBuffer(jsonBytes)
+		payload = jsonBytes
 	}
 
 	url := fmt.Sprintf("%s/%s", c.BaseURL, endpoint)
+	if c.APIKey != "" {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, c.APIKey)
+	}
 
-	req, err := http.NewRequest(method, url, reqBody)
-	if err != nil {
-		return nil, err
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if payload != nil {
+			reqBody = bytes.NewReader(payload)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("x-api-key", c.APIKey)
+
+		fields := map[string]interface{}{
+			"method":   method,
+			"endpoint": endpoint,
+			"attempt":  attempt + 1,
+		}
+		if payload != nil {
+			tflog.Trace(ctx, "Dokploy API request body", withField(fields, "request_body", maskBody(payload)))
+		}
+
+		start := time.Now()
+		resp, err := c.HTTPClient.Do(req)
+		fields["latency_ms"] = time.Since(start).Milliseconds()
+		if err != nil {
+			tflog.Debug(ctx, "Dokploy API request failed", withField(fields, "error", err.Error()))
+			if ctx.Err() == nil && attempt < c.MaxRetries && isRetryableNetworkError(method, err) {
+				if err := c.wait(ctx, attempt); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		respBytes, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			if ctx.Err() == nil && attempt < c.MaxRetries && isIdempotentMethod(method) {
+				if err := c.wait(ctx, attempt); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		fields["status"] = resp.StatusCode
+		tflog.Debug(ctx, "Dokploy API request", fields)
+		tflog.Trace(ctx, "Dokploy API response body", withField(fields, "response_body", maskBody(respBytes)))
+
+		if resp.StatusCode >= 400 {
+			if attempt < c.MaxRetries && isRetryableStatus(method, resp.StatusCode) {
+				delay := c.backoff(attempt)
+				if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+					delay = min(retryAfter, c.RetryMaxWait)
+				}
+				if err := Sleep(ctx, delay); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, newAPIError(method, endpoint, resp.StatusCode, respBytes)
+		}
+
+		return respBytes, nil
 	}
+}
+
+// --- Logging ---
+
+const maskedValue = "***"
+
+// sensitiveBodyKeys are JSON keys whose values never reach the logs.
+var sensitiveBodyKeys = map[string]bool{
+	"x-api-key":            true,
+	"apikey":               true,
+	"api_key":              true,
+	"token":                true,
+	"password":             true,
+	"databasepassword":     true,
+	"databaserootpassword": true,
+	"privatekey":           true,
+	"secretaccesskey":      true,
+	"secretkey":            true,
+	"accesskey":            true,
+	"accesskeyid":          true,
+	"buildsecrets":         true,
+	"certificatedata":      true,
+}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-api-key", c.APIKey)
+// envBodyKeys hold dotenv formatted strings; their keys are kept for
+// debugging but every value is masked.
+var envBodyKeys = map[string]bool{
+	"env":        true,
+	"previewenv": true,
+	"buildargs":  true,
+}
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
+// maskBody returns body with sensitive JSON values masked. Bodies that are not
+// JSON objects or arrays are logged only by size.
+func maskBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return fmt.Sprintf("<%d bytes, not JSON>", len(body))
 	}
-	defer resp.Body.Close()
 
-	respBytes, err := io.ReadAll(resp.Body)
+	masked, err := json.Marshal(maskValue("", decoded))
 	if err != nil {
-		return nil, err
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+	return string(masked)
+}
+
+func maskValue(key string, value interface{}) interface{} {
+	lowerKey := strings.ToLower(key)
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(typed))
+		for k, v := range typed {
+			result[k] = maskValue(k, v)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(typed))
+		for i, v := range typed {
+			result[i] = maskValue(key, v)
+		}
+		return result
+	case string:
+		if sensitiveBodyKeys[lowerKey] && typed != "" {
+			return maskedValue
+		}
+		if envBodyKeys[lowerKey] {
+			return maskEnvValues(typed)
+		}
+		return typed
+	default:
+		if sensitiveBodyKeys[lowerKey] && value != nil {
+			return maskedValue
+		}
+		return value
+	}
+}
+
+// maskEnvValues keeps the variable names of a dotenv string and masks values.
+func maskEnvValues(env string) string {
+	lines := strings.Split(env, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if key, _, ok := strings.Cut(line, "="); ok {
+			lines[i] = key + "=" + maskedValue
+		} else {
+			lines[i] = maskedValue
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func withField(fields map[string]interface{}, key string, value interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(fields)+1)
+	for k, v := range fields {
+		result[k] = v
+	}
+	result[key] = value
+	return result
+}
+
+// --- Retry ---
+
+// backoff returns the delay before retry number attempt (zero based): an
+// exponential step capped at RetryMaxWait, with jitter over its upper half so
+// parallel resource operations do not retry in lockstep.
+func (c *DokployClient) backoff(attempt int) time.Duration {
+	minWait := c.RetryMinWait
+	if minWait <= 0 {
+		minWait = DefaultRetryMinWait
+	}
+	maxWait := c.RetryMaxWait
+	if maxWait <= 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+
+	wait := maxWait
+	if attempt < 32 {
+		if step := minWait << attempt; step > 0 && step < maxWait {
+			wait = step
+		}
+	}
+
+	half := wait / 2
+	return half + rand.N(half+1)
+}
+
+func (c *DokployClient) wait(ctx context.Context, attempt int) error {
+	return Sleep(ctx, c.backoff(attempt))
+}
+
+// Sleep pauses for d or until ctx is done, whichever comes first, returning
+// the context's error in the latter case.
+func Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// envUpdateAttempts bounds how often applyEnvUpdate re-applies a change that
+// a concurrent writer overwrote.
+const envUpdateAttempts = 5
+
+// applyEnvUpdate applies updateFn to an env string with optimistic
+// concurrency: it reads the env, writes the updated env and reads it back, and
+// starts over with backoff when another writer changed it in between. Request
+// errors are returned as is, since doRequest has already retried them.
+func (c *DokployClient) applyEnvUpdate(ctx context.Context, updateFn func(envMap map[string]string), read func() (string, error), write func(env string) error) error {
+	for attempt := 0; ; attempt++ {
+		original, err := read()
+		if err != nil {
+			return err
+		}
+		updated, err := updateEnv(original, updateFn)
+		if err != nil {
+			return err
+		}
+		if updated == original {
+			return nil
+		}
+
+		if err := write(updated); err != nil {
+			return err
+		}
+
+		written, err := read()
+		if err != nil {
+			return fmt.Errorf("failed to verify environment update: %w", err)
+		}
+		if written == updated {
+			return nil
+		}
+
+		if attempt+1 >= envUpdateAttempts {
+			return fmt.Errorf("environment update conflict: the env was changed concurrently %d times", envUpdateAttempts)
+		}
+		if err := c.wait(ctx, attempt); err != nil {
+			return err
+		}
+	}
+}
+
+func isIdempotentMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// isRetryableStatus reports whether a response status is worth retrying. 429
+// and 503 mean the request was rejected before being processed, so any method
+// may be re-sent; gateway errors are only retried for idempotent reads since a
+// mutation may already have reached Dokploy.
+func isRetryableStatus(method string, status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotentMethod(method)
+	default:
+		return false
+	}
+}
+
+// isRetryableNetworkError retries idempotent requests on any transport error,
+// and mutations only when the connection could not be established at all.
+func isRetryableNetworkError(method string, err error) bool {
+	if isIdempotentMethod(method) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// parseRetryAfter understands both forms of the Retry-After header: a number
+// of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		delay := at.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// --- Errors ---
+
+// APIError describes a non-2xx response from the Dokploy API.
+type APIError struct {
+	Method     string
+	Endpoint   string
+	StatusCode int
+	// Code is the tRPC error code (e.g. NOT_FOUND, CONFLICT) when the body
+	// carried one.
+	Code    string
+	Message string
+	Body    string
+}
+
+func (e *APIError) Error() string {
+	status := fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Code != "" {
+		status += " (" + e.Code + ")"
+	}
+	message := e.Message
+	if message == "" {
+		message = e.Body
+	}
+	return fmt.Sprintf("API error: %s %s returned %s - %s", e.Method, e.Endpoint, status, message)
+}
+
+func (e *APIError) notFound() bool {
+	return e.StatusCode == http.StatusNotFound || e.Code == "NOT_FOUND"
+}
+
+// missingProcedure reports whether the endpoint itself does not exist on the
+// server, as opposed to the object it was asked for.
+func (e *APIError) missingProcedure() bool {
+	return e.notFound() && strings.Contains(e.Message, "procedure on path")
+}
+
+func newAPIError(method, endpoint string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		Method:     method,
+		Endpoint:   endpoint,
+		StatusCode: statusCode,
+		Body:       strings.TrimSpace(string(body)),
+	}
+
+	// Dokploy answers with either the OpenAPI shape ({message, code}) or a
+	// raw tRPC envelope ({error: {json: {message, data: {code}}}}).
+	var parsed struct {
+		Message string `json:"message"`
+		Code    any    `json:"code"`
+		Data    struct {
+			Code string `json:"code"`
+		} `json:"data"`
+		Error struct {
+			Message string `json:"message"`
+			JSON    struct {
+				Message string `json:"message"`
+				Data    struct {
+					Code string `json:"code"`
+				} `json:"data"`
+			} `json:"json"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		apiErr.Message = firstNonEmpty(parsed.Message, parsed.Error.JSON.Message, parsed.Error.Message)
+		code, _ := parsed.Code.(string)
+		apiErr.Code = firstNonEmpty(code, parsed.Data.Code, parsed.Error.JSON.Data.Code)
+	}
+
+	return apiErr
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// apiErrors collects every *APIError in err's tree, including errors joined
+// by endpoint fallbacks that wrap more than one cause.
+func apiErrors(err error) []*APIError {
+	if err == nil {
+		return nil
+	}
+
+	var result []*APIError
+	if apiErr, ok := err.(*APIError); ok {
+		result = append(result, apiErr)
+	}
+	switch wrapped := err.(type) {
+	case interface{ Unwrap() error }:
+		result = append(result, apiErrors(wrapped.Unwrap())...)
+	case interface{ Unwrap() []error }:
+		for _, inner := range wrapped.Unwrap() {
+			result = append(result, apiErrors(inner)...)
+		}
 	}
+	return result
+}
 
-	// fmt.Fprintf(os.Stderr, "DEBUG RESPONSE [%s]: %s\n", endpoint, string(respBytes))
+// IsNotFound reports whether err means the requested object does not exist.
+// When err combines a primary endpoint and its fallbacks, every attempt that
+// reached an existing procedure must agree that the object is gone.
+func IsNotFound(err error) bool {
+	found := false
+	for _, apiErr := range apiErrors(err) {
+		if apiErr.missingProcedure() {
+			continue
+		}
+		if !apiErr.notFound() {
+			return false
+		}
+		found = true
+	}
+	return found
+}
 
-	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(respBytes))
+// IsConflict reports whether err is a 409 / CONFLICT response.
+func IsConflict(err error) bool {
+	for _, apiErr := range apiErrors(err) {
+		if apiErr.StatusCode == http.StatusConflict || apiErr.Code == "CONFLICT" {
+			return true
+		}
 	}
+	return false
+}
 
-	return respBytes, nil
+// IsUnauthorized reports whether err is a 401 / UNAUTHORIZED response,
+// usually caused by a missing or revoked API key.
+func IsUnauthorized(err error) bool {
+	for _, apiErr := range apiErrors(err) {
+		if apiErr.StatusCode == http.StatusUnauthorized || apiErr.Code == "UNAUTHORIZED" {
+			return true
+		}
+	}
+	return false
 }
 
 // --- Settings / Traefik ---
 
-func (c *DokployClient) ReadTraefikConfig(serverID *string) (string, error) {
-	return c.ReadScopedTraefikConfig("main", serverID)
+func (c *DokployClient) ReadTraefikConfig(ctx context.Context, serverID *string) (string, error) {
+	return c.ReadScopedTraefikConfig(ctx, "main", serverID)
 }
 
-func (c *DokployClient) UpdateTraefikConfig(serverID *string, config string) error {
-	return c.UpdateScopedTraefikConfig("main", serverID, config)
+func (c *DokployClient) UpdateTraefikConfig(ctx context.Context, serverID *string, config string) error {
+	return c.UpdateScopedTraefikConfig(ctx, "main", serverID, config)
 }
 
-func (c *DokployClient) ReadWebServerTraefikConfig(serverID *string) (string, error) {
-	return c.ReadScopedTraefikConfig("web_server", serverID)
+func (c *DokployClient) ReadWebServerTraefikConfig(ctx context.Context, serverID *string) (string, error) {
+	return c.ReadScopedTraefikConfig(ctx, "web_server", serverID)
 }
 
-func (c *DokployClient) UpdateWebServerTraefikConfig(serverID *string, config string) error {
-	return c.UpdateScopedTraefikConfig("web_server", serverID, config)
+func (c *DokployClient) UpdateWebServerTraefikConfig(ctx context.Context, serverID *string, config string) error {
+	return c.UpdateScopedTraefikConfig(ctx, "web_server", serverID, config)
 }
 
-func (c *DokployClient) ReadMiddlewareTraefikConfig(serverID *string) (string, error) {
-	return c.ReadScopedTraefikConfig("middleware", serverID)
+func (c *DokployClient) ReadMiddlewareTraefikConfig(ctx context.Context, serverID *string) (string, error) {
+	return c.ReadScopedTraefikConfig(ctx, "middleware", serverID)
 }
 
-func (c *DokployClient) UpdateMiddlewareTraefikConfig(serverID *string, config string) error {
-	return c.UpdateScopedTraefikConfig("middleware", serverID, config)
+func (c *DokployClient) UpdateMiddlewareTraefikConfig(ctx context.Context, serverID *string, config string) error {
+	return c.UpdateScopedTraefikConfig(ctx, "middleware", serverID, config)
 }
 
-func (c *DokployClient) ReadScopedTraefikConfig(scope string, serverID *string) (string, error) {
+func (c *DokployClient) ReadScopedTraefikConfig(ctx context.Context, scope string, serverID *string) (string, error) {
 	normalizedScope, err := normalizeTraefikConfigScope(scope)
 	if err != nil {
 		return "", err
@@ -109,7 +570,7 @@ func (c *DokployClient) ReadScopedTraefikConfig(scope string, serverID *string)
 		endpoint = fmt.Sprintf("%s?serverId=%s", endpoint, url.QueryEscape(strings.TrimSpace(*serverID)))
 	}
 
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return "", err
 	}
@@ -117,7 +578,7 @@ func (c *DokployClient) ReadScopedTraefikConfig(scope string, serverID *string)
 	return parseTraefikConfigResponse(resp)
 }
 
-func (c *DokployClient) UpdateScopedTraefikConfig(scope string, serverID *string, config string) error {
+func (c *DokployClient) UpdateScopedTraefikConfig(ctx context.Context, scope string, serverID *string, config string) error {
 	normalizedScope, err := normalizeTraefikConfigScope(scope)
 	if err != nil {
 		return err
@@ -137,7 +598,7 @@ func (c *DokployClient) UpdateScopedTraefikConfig(scope string, serverID *string
 	for _, key := range payloadKeys {
 		payload := cloneMap(basePayload)
 		payload[key] = config
-		_, err := c.doRequest("POST", endpoint, payload)
+		_, err := c.doRequest(ctx, "POST", endpoint, payload)
 		if err == nil {
 			return nil
 		}
@@ -147,13 +608,13 @@ func (c *DokployClient) UpdateScopedTraefikConfig(scope string, serverID *string
 	return lastErr
 }
 
-func (c *DokployClient) ReloadTraefik(serverID *string) error {
+func (c *DokployClient) ReloadTraefik(ctx context.Context, serverID *string) error {
 	payload := map[string]interface{}{}
 	if serverID != nil && strings.TrimSpace(*serverID) != "" {
 		payload["serverId"] = strings.TrimSpace(*serverID)
 	}
 
-	_, err := c.doRequest("POST", "settings.reloadTraefik", payload)
+	_, err := c.doRequest(ctx, "POST", "settings.reloadTraefik", payload)
 	return err
 }
 
@@ -291,8 +752,8 @@ type User struct {
 	OrganizationID string `json:"organizationId"`
 }
 
-func (c *DokployClient) GetUser() (*User, error) {
-	resp, err := c.doRequest("GET", "user.get", nil)
+func (c *DokployClient) GetUser(ctx context.Context) (*User, error) {
+	resp, err := c.doRequest(ctx, "GET", "user.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -329,12 +790,33 @@ type projectResponse struct {
 	Project Project `json:"project"`
 }
 
-func (c *DokployClient) CreateProject(name, description string) (*Project, error) {
+func (c *DokployClient) ListProjects(ctx context.Context) ([]Project, error) {
+	resp, err := c.doRequest(ctx, "GET", "project.all", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []Project
+	if err := json.Unmarshal(resp, &list); err == nil {
+		return list, nil
+	}
+
+	var wrapper struct {
+		Projects []Project `json:"projects"`
+	}
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.Projects != nil {
+		return wrapper.Projects, nil
+	}
+
+	return nil, fmt.Errorf("failed to parse project.all response")
+}
+
+func (c *DokployClient) CreateProject(ctx context.Context, name, description string) (*Project, error) {
 	payload := map[string]string{
 		"name":        name,
 		"description": description,
 	}
-	resp, err := c.doRequest("POST", "project.create", payload)
+	resp, err := c.doRequest(ctx, "POST", "project.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -346,9 +828,9 @@ func (c *DokployClient) CreateProject(name, description string) (*Project, error
 	return &result.Project, nil
 }
 
-func (c *DokployClient) GetProject(id string) (*Project, error) {
+func (c *DokployClient) GetProject(ctx context.Context, id string) (*Project, error) {
 	endpoint := fmt.Sprintf("project.one?projectId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -360,21 +842,21 @@ func (c *DokployClient) GetProject(id string) (*Project, error) {
 	return &result, nil
 }
 
-func (c *DokployClient) DeleteProject(id string) error {
+func (c *DokployClient) DeleteProject(ctx context.Context, id string) error {
 	payload := map[string]string{
 		"projectId": id,
 	}
-	_, err := c.doRequest("POST", "project.remove", payload)
+	_, err := c.doRequest(ctx, "POST", "project.remove", payload)
 	return err
 }
 
-func (c *DokployClient) UpdateProject(id, name, description string) (*Project, error) {
+func (c *DokployClient) UpdateProject(ctx context.Context, id, name, description string) (*Project, error) {
 	payload := map[string]string{
 		"projectId":   id,
 		"name":        name,
 		"description": description,
 	}
-	resp, err := c.doRequest("POST", "project.update", payload)
+	resp, err := c.doRequest(ctx, "POST", "project.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -386,77 +868,72 @@ func (c *DokployClient) UpdateProject(id, name, description string) (*Project, e
 	return &result, nil
 }
 
-func (c *DokployClient) UpdateProjectEnv(projectID string, updateFn func(envMap map[string]string)) error {
-	var lastErr error
-
-	for i := 0; i < 5; i++ {
-		project, err := c.GetProject(projectID)
-		if err != nil {
+func (c *DokployClient) UpdateProjectEnv(ctx context.Context, projectID string, updateFn func(envMap map[string]string)) error {
+	var project *Project
+	return c.applyEnvUpdate(ctx, updateFn,
+		func() (string, error) {
+			var err error
+			project, err = c.GetProject(ctx, projectID)
+			if err != nil {
+				return "", err
+			}
+			return project.Env, nil
+		},
+		func(env string) error {
+			payload := map[string]interface{}{
+				"projectId":   projectID,
+				"name":        project.Name,
+				"description": project.Description,
+				"env":         env,
+			}
+			_, err := c.doRequest(ctx, "POST", "project.update", payload)
 			return err
-		}
-
-		envMap := ParseEnv(project.Env)
-		originalEnvStr := project.Env
-
-		updateFn(envMap)
-
-		newEnvStr := formatEnv(envMap)
-		if newEnvStr == originalEnvStr {
-			return nil
-		}
-
-		payload := map[string]interface{}{
-			"projectId":   projectID,
-			"name":        project.Name,
-			"description": project.Description,
-			"env":         newEnvStr,
-		}
-
-		_, err = c.doRequest("POST", "project.update", payload)
-		if err != nil {
-			lastErr = err
-			time.Sleep(time.Duration(100*(i+1)) * time.Millisecond)
-			continue
-		}
+		},
+	)
+}
 
-		verifyProject, err := c.GetProject(projectID)
-		if err != nil {
-			lastErr = fmt.Errorf("failed to verify environment update: %w", err)
-			time.Sleep(time.Duration(100*(i+1)) * time.Millisecond)
-			continue
-		}
+// --- Environment ---
 
-		if verifyProject.Env == newEnvStr {
-			return nil
-		}
+type Environment struct {
+	ID           string        `json:"environmentId"`
+	Name         string        `json:"name"`
+	Description  string        `json:"description"`
+	ProjectID    string        `json:"projectId"`
+	Env          string        `json:"env"`
+	Applications []Application `json:"applications"`
+	Compose      []Compose     `json:"compose"`
+	Postgres     []Database    `json:"postgres"`
+	Mysql        []Database    `json:"mysql"`
+	Mariadb      []Database    `json:"mariadb"`
+	Mongo        []Database    `json:"mongo"`
+	Redis        []Database    `json:"redis"`
+}
 
-		lastErr = fmt.Errorf("environment update conflict, retrying")
+// DatabasesOfType returns the environment's databases for a Dokploy engine type.
+func (e Environment) DatabasesOfType(dbType string) []Database {
+	switch dbType {
+	case "postgres":
+		return e.Postgres
+	case "mysql":
+		return e.Mysql
+	case "mariadb":
+		return e.Mariadb
+	case "mongo":
+		return e.Mongo
+	case "redis":
+		return e.Redis
+	default:
+		return nil
 	}
-
-	return lastErr
 }
 
-// --- Environment ---
-
-type Environment struct {
-	ID          string     `json:"environmentId"`
-	Name        string     `json:"name"`
-	Description string     `json:"description"`
-	ProjectID   string     `json:"projectId"`
-	Postgres    []Database `json:"postgres"`
-	Mysql       []Database `json:"mysql"`
-	Mariadb     []Database `json:"mariadb"`
-	Mongo       []Database `json:"mongo"`
-	Redis       []Database `json:"redis"`
-}
-
-func (c *DokployClient) CreateEnvironment(projectID, name, description string) (*Environment, error) {
+func (c *DokployClient) CreateEnvironment(ctx context.Context, projectID, name, description string) (*Environment, error) {
 	payload := map[string]string{
 		"projectId":   projectID,
 		"name":        name,
 		"description": description,
 	}
-	resp, err := c.doRequest("POST", "environment.create", payload)
+	resp, err := c.doRequest(ctx, "POST", "environment.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -475,14 +952,14 @@ func (c *DokployClient) CreateEnvironment(projectID, name, description string) (
 	return &result, nil
 }
 
-func (c *DokployClient) UpdateEnvironment(env Environment) (*Environment, error) {
+func (c *DokployClient) UpdateEnvironment(ctx context.Context, env Environment) (*Environment, error) {
 	payload := map[string]interface{}{
 		"environmentId": env.ID,
 		"name":          env.Name,
 		"description":   env.Description,
 		"projectId":     env.ProjectID,
 	}
-	resp, err := c.doRequest("POST", "environment.update", payload)
+	resp, err := c.doRequest(ctx, "POST", "environment.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -501,11 +978,60 @@ func (c *DokployClient) UpdateEnvironment(env Environment) (*Environment, error)
 	return &result, nil
 }
 
-func (c *DokployClient) DeleteEnvironment(id string) error {
+func (c *DokployClient) GetEnvironment(ctx context.Context, id string) (*Environment, error) {
+	endpoint := fmt.Sprintf("environment.one?environmentId=%s", id)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Environment
+	if err := json.Unmarshal(resp, &result); err == nil && result.ID != "" {
+		return &result, nil
+	}
+
+	var wrapper struct {
+		Environment Environment `json:"environment"`
+	}
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.Environment.ID != "" {
+		return &wrapper.Environment, nil
+	}
+
+	return nil, fmt.Errorf("failed to parse environment.one response: %s", string(resp))
+}
+
+// UpdateEnvironmentEnv updates the variables shared by the services of an
+// environment. Like UpdateProjectEnv it re-reads the environment and retries
+// until the written env is read back.
+func (c *DokployClient) UpdateEnvironmentEnv(ctx context.Context, environmentID string, updateFn func(envMap map[string]string)) error {
+	var env *Environment
+	return c.applyEnvUpdate(ctx, updateFn,
+		func() (string, error) {
+			var err error
+			env, err = c.GetEnvironment(ctx, environmentID)
+			if err != nil {
+				return "", err
+			}
+			return env.Env, nil
+		},
+		func(value string) error {
+			payload := map[string]interface{}{
+				"environmentId": environmentID,
+				"name":          env.Name,
+				"description":   env.Description,
+				"env":           value,
+			}
+			_, err := c.doRequest(ctx, "POST", "environment.update", payload)
+			return err
+		},
+	)
+}
+
+func (c *DokployClient) DeleteEnvironment(ctx context.Context, id string) error {
 	payload := map[string]string{
 		"environmentId": id,
 	}
-	_, err := c.doRequest("POST", "environment.remove", payload)
+	_, err := c.doRequest(ctx, "POST", "environment.remove", payload)
 	return err
 }
 
@@ -514,6 +1040,7 @@ func (c *DokployClient) DeleteEnvironment(id string) error {
 type Application struct {
 	ID                string   `json:"applicationId"`
 	Name              string   `json:"name"`
+	AppName           string   `json:"appName"`
 	ProjectID         string   `json:"projectId"`
 	EnvironmentID     string   `json:"environmentId"`
 	RepositoryURL     string   `json:"repository"`
@@ -527,6 +1054,15 @@ type Application struct {
 	Ports             []Port   `json:"ports"`
 	Mounts            []Mount  `json:"mounts"`
 	AutoDeploy        bool     `json:"autoDeploy"`
+	ApplicationStatus string   `json:"applicationStatus"`
+	ServerID          string   `json:"serverId"`
+	// RegistryID references a registry managed with the registry endpoints.
+	// When set on update, an empty string detaches the registry.
+	RegistryID *string `json:"registryId"`
+	// Redirects are the regex redirect rules of the application.
+	Redirects []Redirect `json:"redirects"`
+	// Security holds the basic-auth credentials protecting the application.
+	Security []Security `json:"security"`
 	// Enhanced fields
 	SourceType         string `json:"sourceType"`
 	CustomGitUrl       string `json:"customGitUrl"`
@@ -538,6 +1074,14 @@ type Application struct {
 	// Docker Provider fields
 	DockerImage string `json:"dockerImage"`
 	RegistryURL string `json:"registryUrl"`
+	// Build settings. BuildArgs and BuildSecrets use the KEY=value format of
+	// FormatEnv; nil leaves them untouched on update.
+	BuildArgs        *string `json:"buildArgs"`
+	BuildSecrets     *string `json:"buildSecrets"`
+	HerokuVersion    string  `json:"herokuVersion"`
+	PublishDirectory string  `json:"publishDirectory"`
+	IsStaticSpa      *bool   `json:"isStaticSpa"`
+	RailpackVersion  string  `json:"railpackVersion"`
 	// GitHub Provider fields
 	GithubRepository string            `json:"githubRepository"`
 	GithubOwner      string            `json:"owner"`
@@ -561,25 +1105,146 @@ type Application struct {
 	PreviewEnv                            string   `json:"previewEnv"`
 	PreviewBuildArgs                      string   `json:"previewBuildArgs"`
 	PreviewLabels                         []string `json:"previewLabels"`
+	// Resource limits and Swarm service settings
+	ApplicationSwarm
 }
 
-func (c *DokployClient) CreateApplication(app Application) (*Application, error) {
-	// 1. Create minimal application
-	createPayload := map[string]string{
-		"name":          app.Name,
-		"environmentId": app.EnvironmentID,
-	}
+// ApplicationSwarm holds the resource limits and Docker Swarm service settings
+// of an application. Durations are in nanoseconds, memory in bytes and CPU in
+// nano CPUs, as Docker expects them. On update nil fields are left untouched,
+// while pointers to zero values and non-nil empty slices clear the setting.
+type ApplicationSwarm struct {
+	MemoryReservation *string             `json:"memoryReservation"`
+	MemoryLimit       *string             `json:"memoryLimit"`
+	CPUReservation    *string             `json:"cpuReservation"`
+	CPULimit          *string             `json:"cpuLimit"`
+	Replicas          *int64              `json:"replicas"`
+	Command           *string             `json:"command"`
+	Args              []string            `json:"args"`
+	HealthCheck       *HealthCheckSwarm   `json:"healthCheckSwarm"`
+	RestartPolicy     *RestartPolicySwarm `json:"restartPolicySwarm"`
+	Placement         *PlacementSwarm     `json:"placementSwarm"`
+	UpdateConfig      *UpdateConfigSwarm  `json:"updateConfigSwarm"`
+	RollbackConfig    *UpdateConfigSwarm  `json:"rollbackConfigSwarm"`
+	Mode              *ModeSwarm          `json:"modeSwarm"`
+	Networks          []NetworkSwarm      `json:"networkSwarm"`
+}
 
-	resp, err := c.doRequest("POST", "application.create", createPayload)
-	if err != nil {
-		return nil, err
-	}
+type HealthCheckSwarm struct {
+	Test        []string `json:"Test,omitempty"`
+	Interval    int64    `json:"Interval,omitempty"`
+	Timeout     int64    `json:"Timeout,omitempty"`
+	StartPeriod int64    `json:"StartPeriod,omitempty"`
+	Retries     int64    `json:"Retries,omitempty"`
+}
 
-	var wrapper struct {
-		Application Application `json:"application"`
-	}
-	if err := json.Unmarshal(resp, &wrapper); err != nil {
-		return nil, err
+type RestartPolicySwarm struct {
+	Condition   string `json:"Condition,omitempty"`
+	Delay       int64  `json:"Delay,omitempty"`
+	MaxAttempts int64  `json:"MaxAttempts,omitempty"`
+	Window      int64  `json:"Window,omitempty"`
+}
+
+type PlacementSwarm struct {
+	Constraints []string                   `json:"Constraints,omitempty"`
+	Preferences []PlacementPreferenceSwarm `json:"Preferences,omitempty"`
+	MaxReplicas int64                      `json:"MaxReplicas,omitempty"`
+}
+
+type PlacementPreferenceSwarm struct {
+	Spread struct {
+		SpreadDescriptor string `json:"SpreadDescriptor"`
+	} `json:"Spread"`
+}
+
+// UpdateConfigSwarm is used for both the update and the rollback config.
+type UpdateConfigSwarm struct {
+	Parallelism     int64   `json:"Parallelism"`
+	Delay           int64   `json:"Delay,omitempty"`
+	FailureAction   string  `json:"FailureAction,omitempty"`
+	Monitor         int64   `json:"Monitor,omitempty"`
+	MaxFailureRatio float64 `json:"MaxFailureRatio,omitempty"`
+	Order           string  `json:"Order,omitempty"`
+}
+
+type ModeSwarm struct {
+	Replicated *ReplicatedModeSwarm `json:"Replicated,omitempty"`
+	Global     *struct{}            `json:"Global,omitempty"`
+}
+
+type ReplicatedModeSwarm struct {
+	Replicas int64 `json:"Replicas,omitempty"`
+}
+
+type NetworkSwarm struct {
+	Target     string            `json:"Target"`
+	Aliases    []string          `json:"Aliases,omitempty"`
+	DriverOpts map[string]string `json:"DriverOpts,omitempty"`
+}
+
+func addApplicationSwarmPayload(payload map[string]interface{}, swarm ApplicationSwarm) {
+	setSwarmField(payload, "memoryReservation", swarm.MemoryReservation)
+	setSwarmField(payload, "memoryLimit", swarm.MemoryLimit)
+	setSwarmField(payload, "cpuReservation", swarm.CPUReservation)
+	setSwarmField(payload, "cpuLimit", swarm.CPULimit)
+	if swarm.Replicas != nil {
+		payload["replicas"] = *swarm.Replicas
+	}
+	setSwarmField(payload, "command", swarm.Command)
+	setSwarmList(payload, "args", swarm.Args)
+	setSwarmField(payload, "healthCheckSwarm", swarm.HealthCheck)
+	setSwarmField(payload, "restartPolicySwarm", swarm.RestartPolicy)
+	setSwarmField(payload, "placementSwarm", swarm.Placement)
+	setSwarmField(payload, "updateConfigSwarm", swarm.UpdateConfig)
+	setSwarmField(payload, "rollbackConfigSwarm", swarm.RollbackConfig)
+	setSwarmField(payload, "modeSwarm", swarm.Mode)
+	setSwarmList(payload, "networkSwarm", swarm.Networks)
+}
+
+// setSwarmField adds a setting to the payload. nil is omitted and a pointer to
+// a zero value is sent as null, which clears the setting in Dokploy.
+func setSwarmField[T any](payload map[string]interface{}, key string, value *T) {
+	if value == nil {
+		return
+	}
+	if reflect.ValueOf(value).Elem().IsZero() {
+		payload[key] = nil
+		return
+	}
+	payload[key] = *value
+}
+
+func setSwarmList[T any](payload map[string]interface{}, key string, values []T) {
+	if values == nil {
+		return
+	}
+	if len(values) == 0 {
+		payload[key] = nil
+		return
+	}
+	payload[key] = values
+}
+
+func (c *DokployClient) CreateApplication(ctx context.Context, app Application) (*Application, error) {
+	// 1. Create minimal application
+	createPayload := map[string]string{
+		"name":          app.Name,
+		"environmentId": app.EnvironmentID,
+	}
+	if app.ServerID != "" {
+		createPayload["serverId"] = app.ServerID
+	}
+
+	resp, err := c.doRequest(ctx, "POST", "application.create", createPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapper struct {
+		Application Application `json:"application"`
+	}
+	if err := json.Unmarshal(resp, &wrapper); err != nil {
+		return nil, err
 	}
 
 	createdApp := wrapper.Application
@@ -632,7 +1297,12 @@ func (c *DokployClient) CreateApplication(app Application) (*Application, error)
 	if app.LabelsSwarm != nil {
 		updatePayload["labelsSwarm"] = app.LabelsSwarm
 	}
+	if app.RegistryID != nil && *app.RegistryID != "" {
+		updatePayload["registryId"] = *app.RegistryID
+	}
 	addPreviewApplicationPayload(updatePayload, app)
+	addApplicationSwarmPayload(updatePayload, app.ApplicationSwarm)
+	addBuildArgsPayload(updatePayload, app)
 
 	// Ensure defaults
 	if app.SourceType == "" {
@@ -643,13 +1313,13 @@ func (c *DokployClient) CreateApplication(app Application) (*Application, error)
 		}
 	}
 
-	respUpdate, err := c.doRequest("POST", "application.update", updatePayload)
+	respUpdate, err := c.doRequest(ctx, "POST", "application.update", updatePayload)
 	if err != nil {
 		return nil, fmt.Errorf("created application %s but failed to update config: %w", createdApp.ID, err)
 	}
 
 	if string(respUpdate) == "true" {
-		return c.GetApplication(createdApp.ID)
+		return c.GetApplication(ctx, createdApp.ID)
 	}
 
 	var updateResult Application
@@ -663,9 +1333,9 @@ func (c *DokployClient) CreateApplication(app Application) (*Application, error)
 	return &createdApp, nil
 }
 
-func (c *DokployClient) GetApplication(id string) (*Application, error) {
+func (c *DokployClient) GetApplication(ctx context.Context, id string) (*Application, error) {
 	endpoint := fmt.Sprintf("application.one?applicationId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -677,7 +1347,7 @@ func (c *DokployClient) GetApplication(id string) (*Application, error) {
 	return &result, nil
 }
 
-func (c *DokployClient) UpdateApplication(app Application) (*Application, error) {
+func (c *DokployClient) UpdateApplication(ctx context.Context, app Application) (*Application, error) {
 	payload := map[string]interface{}{
 		"applicationId": app.ID,
 		"name":          app.Name,
@@ -724,15 +1394,24 @@ func (c *DokployClient) UpdateApplication(app Application) (*Application, error)
 	if app.EnvironmentID != "" {
 		payload["environmentId"] = app.EnvironmentID
 	}
+	if app.RegistryID != nil {
+		if *app.RegistryID == "" {
+			payload["registryId"] = nil
+		} else {
+			payload["registryId"] = *app.RegistryID
+		}
+	}
 	addPreviewApplicationPayload(payload, app)
+	addApplicationSwarmPayload(payload, app.ApplicationSwarm)
+	addBuildArgsPayload(payload, app)
 
-	resp, err := c.doRequest("POST", "application.update", payload)
+	resp, err := c.doRequest(ctx, "POST", "application.update", payload)
 	if err != nil {
 		return nil, err
 	}
 
 	if strings.TrimSpace(string(resp)) == "true" {
-		return c.GetApplication(app.ID)
+		return c.GetApplication(ctx, app.ID)
 	}
 
 	var wrapper struct {
@@ -789,22 +1468,62 @@ func addPreviewApplicationPayload(payload map[string]interface{}, app Applicatio
 	}
 }
 
-func (c *DokployClient) DeleteApplication(id string) error {
+func addBuildArgsPayload(payload map[string]interface{}, app Application) {
+	if app.BuildArgs != nil {
+		payload["buildArgs"] = *app.BuildArgs
+	}
+	if app.BuildSecrets != nil {
+		payload["buildSecrets"] = *app.BuildSecrets
+	}
+}
+
+// SaveBuildType stores the build type of an application together with the
+// settings of that build type.
+func (c *DokployClient) SaveBuildType(ctx context.Context, app Application) error {
+	payload := map[string]interface{}{
+		"applicationId":     app.ID,
+		"buildType":         app.BuildType,
+		"dockerfile":        nullableString(app.DockerfilePath),
+		"dockerContextPath": nullableString(app.DockerContextPath),
+		"dockerBuildStage":  nullableString(app.DockerBuildStage),
+		"herokuVersion":     nullableString(app.HerokuVersion),
+		"publishDirectory":  nullableString(app.PublishDirectory),
+		"railpackVersion":   nullableString(app.RailpackVersion),
+		"isStaticSpa":       false,
+	}
+	if app.IsStaticSpa != nil {
+		payload["isStaticSpa"] = *app.IsStaticSpa
+	}
+
+	_, err := c.doRequest(ctx, "POST", "application.saveBuildType", payload)
+	return err
+}
+
+// nullableString sends empty strings as null, which Dokploy uses for unset
+// optional settings.
+func nullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+func (c *DokployClient) DeleteApplication(ctx context.Context, id string) error {
 	// Best-effort stop before deletion to make teardown explicit and predictable.
 	// Ignore stop errors; delete call should still reconcile the final state.
-	_ = c.StopApplication(id)
+	_ = c.StopApplication(ctx, id)
 
 	payload := map[string]string{
 		"applicationId": id,
 	}
-	_, err := c.doRequest("POST", "application.delete", payload)
+	_, err := c.doRequest(ctx, "POST", "application.delete", payload)
 	if err == nil {
 		return nil
 	}
 
 	// Backward compatibility with older Dokploy versions that still expose
 	// application.remove instead of application.delete.
-	_, removeErr := c.doRequest("POST", "application.remove", payload)
+	_, removeErr := c.doRequest(ctx, "POST", "application.remove", payload)
 	if removeErr != nil {
 		return fmt.Errorf("application.delete failed: %w; application.remove fallback failed: %w", err, removeErr)
 	}
@@ -812,7 +1531,7 @@ func (c *DokployClient) DeleteApplication(id string) error {
 	return nil
 }
 
-func (c *DokployClient) SaveGithubProvider(appID string, githubConfig map[string]interface{}) error {
+func (c *DokployClient) SaveGithubProvider(ctx context.Context, appID string, githubConfig map[string]interface{}) error {
 	payload := map[string]interface{}{
 		"applicationId": appID,
 	}
@@ -822,11 +1541,11 @@ func (c *DokployClient) SaveGithubProvider(appID string, githubConfig map[string
 		payload[key] = value
 	}
 
-	_, err := c.doRequest("POST", "application.saveGithubProvider", payload)
+	_, err := c.doRequest(ctx, "POST", "application.saveGithubProvider", payload)
 	return err
 }
 
-func (c *DokployClient) SaveDockerProvider(appID string, dockerConfig map[string]interface{}) error {
+func (c *DokployClient) SaveDockerProvider(ctx context.Context, appID string, dockerConfig map[string]interface{}) error {
 	payload := map[string]interface{}{
 		"applicationId": appID,
 	}
@@ -835,23 +1554,33 @@ func (c *DokployClient) SaveDockerProvider(appID string, dockerConfig map[string
 		payload[key] = value
 	}
 
-	_, err := c.doRequest("POST", "application.saveDockerProvider", payload)
+	_, err := c.doRequest(ctx, "POST", "application.saveDockerProvider", payload)
+	return err
+}
+
+func (c *DokployClient) DeployApplication(ctx context.Context, id string) error {
+	payload := map[string]string{
+		"applicationId": id,
+	}
+	_, err := c.doRequest(ctx, "POST", "application.deploy", payload)
 	return err
 }
 
-func (c *DokployClient) DeployApplication(id string) error {
+func (c *DokployClient) StopApplication(ctx context.Context, id string) error {
 	payload := map[string]string{
 		"applicationId": id,
 	}
-	_, err := c.doRequest("POST", "application.deploy", payload)
+	_, err := c.doRequest(ctx, "POST", "application.stop", payload)
 	return err
 }
 
-func (c *DokployClient) StopApplication(id string) error {
+// StartApplication starts the containers of a stopped application without
+// rebuilding it.
+func (c *DokployClient) StartApplication(ctx context.Context, id string) error {
 	payload := map[string]string{
 		"applicationId": id,
 	}
-	_, err := c.doRequest("POST", "application.stop", payload)
+	_, err := c.doRequest(ctx, "POST", "application.start", payload)
 	return err
 }
 
@@ -867,22 +1596,58 @@ type Mount struct {
 	HostPath      string `json:"hostPath"`
 	ServiceType   string `json:"serviceType"`
 	ServiceID     string `json:"serviceId"`
+	// Content and FilePath describe file mounts.
+	Content    string `json:"content"`
+	FilePath   string `json:"filePath"`
+	ComposeID  string `json:"composeId"`
+	PostgresID string `json:"postgresId"`
+	MysqlID    string `json:"mysqlId"`
+	MariadbID  string `json:"mariadbId"`
+	MongoID    string `json:"mongoId"`
+	RedisID    string `json:"redisId"`
 }
 
-func (c *DokployClient) CreateMount(mount Mount) (*Mount, error) {
-	mountType := strings.TrimSpace(mount.MountType)
-	if mountType == "" {
-		mountType = strings.TrimSpace(mount.Type)
+// MountServiceTypes are the service types mounts can be attached to.
+var MountServiceTypes = []string{"application", "compose", "postgres", "mysql", "mariadb", "mongo", "redis"}
+
+// Target returns the service type and ID the mount is attached to. Dokploy
+// returns the ID in the column of the service type rather than in serviceId.
+func (m Mount) Target() (string, string) {
+	serviceType := strings.TrimSpace(m.ServiceType)
+	ids := map[string]string{
+		"application": m.ApplicationID,
+		"compose":     m.ComposeID,
+		"postgres":    m.PostgresID,
+		"mysql":       m.MysqlID,
+		"mariadb":     m.MariadbID,
+		"mongo":       m.MongoID,
+		"redis":       m.RedisID,
+	}
+	if serviceType == "" {
+		for _, candidate := range MountServiceTypes {
+			if ids[candidate] != "" {
+				serviceType = candidate
+				break
+			}
+		}
 	}
-	if mountType == "" {
-		mountType = "volume"
+	if id := ids[serviceType]; id != "" {
+		return serviceType, id
+	}
+	return serviceType, m.ServiceID
+}
+
+func (m Mount) mountType() string {
+	if mountType := strings.TrimSpace(m.MountType); mountType != "" {
+		return mountType
 	}
+	return strings.TrimSpace(m.Type)
+}
 
+func mountPayload(mount Mount, mountType string) map[string]interface{} {
 	payload := map[string]interface{}{
-		"type":        mountType,
-		"serviceType": "application",
-		"serviceId":   mount.ApplicationID,
-		"mountPath":   mount.MountPath,
+		"type":      mountType,
+		"mountPath": mount.MountPath,
 	}
 	if strings.TrimSpace(mount.VolumeName) != "" {
 		payload["volumeName"] = mount.VolumeName
@@ -890,8 +1655,37 @@ func (c *DokployClient) CreateMount(mount Mount) (*Mount, error) {
 	if strings.TrimSpace(mount.HostPath) != "" {
 		payload["hostPath"] = mount.HostPath
 	}
+	if mount.Content != "" {
+		payload["content"] = mount.Content
+	}
+	if strings.TrimSpace(mount.FilePath) != "" {
+		payload["filePath"] = mount.FilePath
+	}
+	return payload
+}
+
+// CreateMount attaches a mount to the service given by ServiceType and
+// ServiceID. ServiceType defaults to application, with ApplicationID as the
+// service ID.
+func (c *DokployClient) CreateMount(ctx context.Context, mount Mount) (*Mount, error) {
+	mountType := mount.mountType()
+	if mountType == "" {
+		mountType = "volume"
+	}
+	serviceType := strings.TrimSpace(mount.ServiceType)
+	if serviceType == "" {
+		serviceType = "application"
+	}
+	serviceID := strings.TrimSpace(mount.ServiceID)
+	if serviceID == "" {
+		serviceID = mount.ApplicationID
+	}
+
+	payload := mountPayload(mount, mountType)
+	payload["serviceType"] = serviceType
+	payload["serviceId"] = serviceID
 
-	resp, err := c.doRequest("POST", "mounts.create", payload)
+	resp, err := c.doRequest(ctx, "POST", "mounts.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -909,7 +1703,7 @@ func (c *DokployClient) CreateMount(mount Mount) (*Mount, error) {
 	}
 
 	if strings.TrimSpace(string(resp)) == "true" {
-		created, err := c.findMountBySignature(mount.ApplicationID, mountType, mount.MountPath, mount.VolumeName)
+		created, err := c.findMountBySignature(ctx, serviceType, serviceID, mountType, mount.MountPath, mount.VolumeName)
 		if err == nil {
 			return created, nil
 		}
@@ -923,12 +1717,14 @@ func (c *DokployClient) CreateMount(mount Mount) (*Mount, error) {
 			MountPath:     mount.MountPath,
 			VolumeName:    mount.VolumeName,
 			HostPath:      mount.HostPath,
-			ServiceType:   "application",
-			ServiceID:     mount.ApplicationID,
+			ServiceType:   serviceType,
+			ServiceID:     serviceID,
+			Content:       mount.Content,
+			FilePath:      mount.FilePath,
 		}, nil
 	}
 
-	created, err := c.findMountBySignature(mount.ApplicationID, mountType, mount.MountPath, mount.VolumeName)
+	created, err := c.findMountBySignature(ctx, serviceType, serviceID, mountType, mount.MountPath, mount.VolumeName)
 	if err == nil {
 		return created, nil
 	}
@@ -942,26 +1738,26 @@ func (c *DokployClient) CreateMount(mount Mount) (*Mount, error) {
 		MountPath:     mount.MountPath,
 		VolumeName:    mount.VolumeName,
 		HostPath:      mount.HostPath,
-		ServiceType:   "application",
-		ServiceID:     mount.ApplicationID,
+		ServiceType:   serviceType,
+		ServiceID:     serviceID,
+		Content:       mount.Content,
+		FilePath:      mount.FilePath,
 	}, nil
 }
 
-func (c *DokployClient) findMountBySignature(applicationID, mountType, mountPath, volumeName string) (*Mount, error) {
-	var lastErr error
-	for i := 0; i < 8; i++ {
-		mounts, err := c.ListMountsByApplication(applicationID)
+// mountLookupAttempts bounds how often findMountBySignature lists the mounts
+// of a service while waiting for a new mount to show up.
+const mountLookupAttempts = 5
+
+func (c *DokployClient) findMountBySignature(ctx context.Context, serviceType, serviceID, mountType, mountPath, volumeName string) (*Mount, error) {
+	for attempt := 0; attempt < mountLookupAttempts; attempt++ {
+		mounts, err := c.ListMounts(ctx, serviceType, serviceID)
 		if err != nil {
-			lastErr = err
-			time.Sleep(time.Duration(100*(i+1)) * time.Millisecond)
-			continue
+			return nil, fmt.Errorf("mount created but mount lookup failed for %s %s: %w", serviceType, serviceID, err)
 		}
 
 		for _, existing := range mounts {
-			existingType := strings.TrimSpace(existing.MountType)
-			if existingType == "" {
-				existingType = strings.TrimSpace(existing.Type)
-			}
+			existingType := existing.mountType()
 			mountTypeMatches := strings.TrimSpace(mountType) == "" || strings.EqualFold(existingType, mountType)
 
 			existingPath := strings.TrimSuffix(strings.TrimSpace(existing.MountPath), "/")
@@ -980,25 +1776,47 @@ func (c *DokployClient) findMountBySignature(applicationID, mountType, mountPath
 			}
 		}
 
-		time.Sleep(time.Duration(100*(i+1)) * time.Millisecond)
-	}
-
-	if lastErr != nil {
-		return nil, fmt.Errorf("mount created but mount lookup failed for application %s: %w", applicationID, lastErr)
+		// The new mount may not be listed yet.
+		if attempt+1 < mountLookupAttempts {
+			if err := c.wait(ctx, attempt); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return nil, fmt.Errorf(
-		"mount created but not found on application %s (mountType=%q, mountPath=%q, volumeName=%q)",
-		applicationID,
+		"mount created but not found on %s %s (mountType=%q, mountPath=%q, volumeName=%q)",
+		serviceType,
+		serviceID,
 		mountType,
 		mountPath,
 		volumeName,
 	)
 }
 
-func (c *DokployClient) ListMountsByApplication(applicationID string) ([]Mount, error) {
+// ListMounts returns the mounts of an application, compose stack or database.
+func (c *DokployClient) ListMounts(ctx context.Context, serviceType, serviceID string) ([]Mount, error) {
+	switch serviceType {
+	case "", "application":
+		return c.ListMountsByApplication(ctx, serviceID)
+	case "compose":
+		comp, err := c.GetCompose(ctx, serviceID)
+		if err != nil {
+			return nil, err
+		}
+		return comp.Mounts, nil
+	default:
+		db, err := c.GetDatabase(ctx, serviceID, serviceType)
+		if err != nil {
+			return nil, err
+		}
+		return db.Mounts, nil
+	}
+}
+
+func (c *DokployClient) ListMountsByApplication(ctx context.Context, applicationID string) ([]Mount, error) {
 	endpoint := fmt.Sprintf("mounts.allNamedByApplicationId?applicationId=%s", applicationID)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1037,21 +1855,60 @@ func (c *DokployClient) ListMountsByApplication(applicationID string) ([]Mount,
 	return nil, fmt.Errorf("failed to parse mounts.allNamedByApplicationId response: %s", string(resp))
 }
 
-func (c *DokployClient) DeleteMount(id string) error {
+func (c *DokployClient) GetMount(ctx context.Context, id string) (*Mount, error) {
+	endpoint := fmt.Sprintf("mounts.one?mountId=%s", url.QueryEscape(id))
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Mount
+	if err := json.Unmarshal(resp, &result); err == nil && result.ID != "" {
+		return &result, nil
+	}
+
+	var wrapper struct {
+		Mount Mount `json:"mount"`
+	}
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.Mount.ID != "" {
+		return &wrapper.Mount, nil
+	}
+
+	return nil, fmt.Errorf("failed to parse mounts.one response: %s", string(resp))
+}
+
+// UpdateMount changes the type, source and path of an existing mount. Fields
+// that do not apply to the mount type are cleared.
+func (c *DokployClient) UpdateMount(ctx context.Context, mount Mount) error {
+	mountType := mount.mountType()
+	payload := map[string]interface{}{
+		"mountId":    mount.ID,
+		"type":       mountType,
+		"mountPath":  mount.MountPath,
+		"hostPath":   nullableString(mount.HostPath),
+		"volumeName": nullableString(mount.VolumeName),
+		"content":    nullableString(mount.Content),
+		"filePath":   nullableString(mount.FilePath),
+	}
+	_, err := c.doRequest(ctx, "POST", "mounts.update", payload)
+	return err
+}
+
+func (c *DokployClient) DeleteMount(ctx context.Context, id string) error {
 	payload := map[string]string{
 		"mountId": id,
 	}
-	_, err := c.doRequest("POST", "mounts.remove", payload)
+	_, err := c.doRequest(ctx, "POST", "mounts.remove", payload)
 	if err == nil {
 		return nil
 	}
 
-	_, fallbackErr := c.doRequest("POST", "mount.delete", payload)
+	_, fallbackErr := c.doRequest(ctx, "POST", "mount.delete", payload)
 	if fallbackErr == nil {
 		return nil
 	}
 
-	_, fallbackDeleteErr := c.doRequest("POST", "mounts.delete", payload)
+	_, fallbackDeleteErr := c.doRequest(ctx, "POST", "mounts.delete", payload)
 	if fallbackDeleteErr == nil {
 		return nil
 	}
@@ -1079,11 +1936,14 @@ type Compose struct {
 	CustomGitSSHKeyId string   `json:"customGitSSHKeyId"`
 	ComposePath       string   `json:"composePath"`
 	AutoDeploy        bool     `json:"autoDeploy"`
+	ComposeStatus     string   `json:"composeStatus"`
+	ServerID          string   `json:"serverId"`
 	Env               string   `json:"env"`
 	Domains           []Domain `json:"domains"`
+	Mounts            []Mount  `json:"mounts"`
 }
 
-func (c *DokployClient) CreateCompose(comp Compose) (*Compose, error) {
+func (c *DokployClient) CreateCompose(ctx context.Context, comp Compose) (*Compose, error) {
 	// 1. Create minimal compose
 	payload := map[string]string{
 		"environmentId": comp.EnvironmentID,
@@ -1096,8 +1956,11 @@ func (c *DokployClient) CreateCompose(comp Compose) (*Compose, error) {
 	if comp.ComposeFile != "" {
 		payload["composeFile"] = comp.ComposeFile
 	}
+	if comp.ServerID != "" {
+		payload["serverId"] = comp.ServerID
+	}
 
-	resp, err := c.doRequest("POST", "compose.create", payload)
+	resp, err := c.doRequest(ctx, "POST", "compose.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -1150,13 +2013,13 @@ func (c *DokployClient) CreateCompose(comp Compose) (*Compose, error) {
 		}
 	}
 
-	respUpdate, err := c.doRequest("POST", "compose.update", updatePayload)
+	respUpdate, err := c.doRequest(ctx, "POST", "compose.update", updatePayload)
 	if err != nil {
 		return nil, fmt.Errorf("created compose %s but failed to update config: %w", createdComp.ID, err)
 	}
 
 	if string(respUpdate) == "true" {
-		return c.GetCompose(createdComp.ID)
+		return c.GetCompose(ctx, createdComp.ID)
 	}
 
 	var updateResult Compose
@@ -1170,9 +2033,9 @@ func (c *DokployClient) CreateCompose(comp Compose) (*Compose, error) {
 	return &createdComp, nil
 }
 
-func (c *DokployClient) GetCompose(id string) (*Compose, error) {
+func (c *DokployClient) GetCompose(ctx context.Context, id string) (*Compose, error) {
 	endpoint := fmt.Sprintf("compose.one?composeId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1183,7 +2046,7 @@ func (c *DokployClient) GetCompose(id string) (*Compose, error) {
 	return &result, nil
 }
 
-func (c *DokployClient) UpdateCompose(comp Compose) (*Compose, error) {
+func (c *DokployClient) UpdateCompose(ctx context.Context, comp Compose) (*Compose, error) {
 	payload := map[string]interface{}{
 		"composeId":  comp.ID,
 		"name":       comp.Name,
@@ -1211,7 +2074,7 @@ func (c *DokployClient) UpdateCompose(comp Compose) (*Compose, error) {
 		payload["environmentId"] = comp.EnvironmentID
 	}
 
-	resp, err := c.doRequest("POST", "compose.update", payload)
+	resp, err := c.doRequest(ctx, "POST", "compose.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -1223,16 +2086,16 @@ func (c *DokployClient) UpdateCompose(comp Compose) (*Compose, error) {
 	return &result, nil
 }
 
-func (c *DokployClient) DeleteCompose(id string, deleteVolumes bool) error {
+func (c *DokployClient) DeleteCompose(ctx context.Context, id string, deleteVolumes bool) error {
 	// Best-effort stop before deletion to make teardown explicit and predictable.
 	// Ignore stop errors; delete call should still reconcile the final state.
-	_ = c.StopCompose(id)
+	_ = c.StopCompose(ctx, id)
 
 	deletePayload := map[string]interface{}{
 		"composeId":     id,
 		"deleteVolumes": deleteVolumes,
 	}
-	_, err := c.doRequest("POST", "compose.delete", deletePayload)
+	_, err := c.doRequest(ctx, "POST", "compose.delete", deletePayload)
 	if err == nil {
 		return nil
 	}
@@ -1242,7 +2105,7 @@ func (c *DokployClient) DeleteCompose(id string, deleteVolumes bool) error {
 	payload := map[string]string{
 		"composeId": id,
 	}
-	_, removeErr := c.doRequest("POST", "compose.remove", payload)
+	_, removeErr := c.doRequest(ctx, "POST", "compose.remove", payload)
 	if removeErr != nil {
 		return fmt.Errorf("compose.delete failed: %w; compose.remove fallback failed: %w", err, removeErr)
 	}
@@ -1250,219 +2113,520 @@ func (c *DokployClient) DeleteCompose(id string, deleteVolumes bool) error {
 	return nil
 }
 
-func (c *DokployClient) DeployCompose(id string) error {
+func (c *DokployClient) DeployCompose(ctx context.Context, id string) error {
 	payload := map[string]string{
 		"composeId": id,
 	}
-	_, err := c.doRequest("POST", "compose.deploy", payload)
+	_, err := c.doRequest(ctx, "POST", "compose.deploy", payload)
 	return err
 }
 
-func (c *DokployClient) StopCompose(id string) error {
+func (c *DokployClient) StopCompose(ctx context.Context, id string) error {
 	payload := map[string]string{
 		"composeId": id,
 	}
-	_, err := c.doRequest("POST", "compose.stop", payload)
+	_, err := c.doRequest(ctx, "POST", "compose.stop", payload)
 	return err
 }
 
-// --- Database ---
+// StartCompose starts the containers of a stopped compose stack without
+// redeploying it.
+func (c *DokployClient) StartCompose(ctx context.Context, id string) error {
+	payload := map[string]string{
+		"composeId": id,
+	}
+	_, err := c.doRequest(ctx, "POST", "compose.start", payload)
+	return err
+}
 
-type Database struct {
-	ID            string `json:"databaseId"`
-	Name          string `json:"name"`
-	AppName       string `json:"appName"`
-	Type          string `json:"type"`
-	ProjectID     string `json:"projectId"`
-	EnvironmentID string `json:"environmentId"`
-	Version       string `json:"version"`
-	DockerImage   string `json:"dockerImage"`
-	ExternalPort  int64  `json:"externalPort"`
-	InternalPort  int64  `json:"internalPort"`
-	Password      string `json:"password"`
-	PostgresID    string `json:"postgresId"`
-	MysqlID       string `json:"mysqlId"`
-	MariadbID     string `json:"mariadbId"`
-	MongoID       string `json:"mongoId"`
-	RedisID       string `json:"redisId"`
+// --- Deployment ---
+
+// Deployment is one entry of an application's or compose stack's deployment
+// history.
+type Deployment struct {
+	ID            string `json:"deploymentId"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	Status        string `json:"status"`
+	LogPath       string `json:"logPath"`
+	ApplicationID string `json:"applicationId"`
+	ComposeID     string `json:"composeId"`
+	ErrorMessage  string `json:"errorMessage"`
+	CreatedAt     string `json:"createdAt"`
+	StartedAt     string `json:"startedAt"`
+	FinishedAt    string `json:"finishedAt"`
 }
 
-func databaseTypeSpecificID(db Database, databaseType string) string {
-	switch databaseType {
-	case "postgres":
-		return db.PostgresID
-	case "mysql":
-		return db.MysqlID
-	case "mariadb":
-		return db.MariadbID
-	case "mongo":
-		return db.MongoID
-	case "redis":
-		return db.RedisID
+const (
+	DeploymentStatusRunning   = "running"
+	DeploymentStatusDone      = "done"
+	DeploymentStatusError     = "error"
+	DeploymentStatusCancelled = "cancelled"
+)
+
+// Finished reports whether the deployment reached a terminal status.
+func (d Deployment) Finished() bool {
+	switch d.Status {
+	case DeploymentStatusDone, DeploymentStatusError, DeploymentStatusCancelled:
+		return true
 	default:
-		return ""
+		return false
 	}
 }
 
-func databaseAnyTypeID(db Database) string {
-	if db.PostgresID != "" {
-		return db.PostgresID
-	}
-	if db.MysqlID != "" {
-		return db.MysqlID
-	}
-	if db.MariadbID != "" {
-		return db.MariadbID
-	}
-	if db.MongoID != "" {
-		return db.MongoID
+// ListDeployments returns the deployment history of an application or a
+// compose stack. serviceType is "application" or "compose".
+func (c *DokployClient) ListDeployments(ctx context.Context, serviceType, serviceID string) ([]Deployment, error) {
+	var endpoint string
+	switch serviceType {
+	case "application":
+		endpoint = fmt.Sprintf("deployment.all?applicationId=%s", serviceID)
+	case "compose":
+		endpoint = fmt.Sprintf("deployment.allByCompose?composeId=%s", serviceID)
+	default:
+		return nil, fmt.Errorf("unsupported deployment service type: %s", serviceType)
 	}
-	if db.RedisID != "" {
-		return db.RedisID
+
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-func normalizeDatabaseID(db *Database, databaseType string) {
-	if db == nil {
-		return
+	var list []Deployment
+	if err := json.Unmarshal(resp, &list); err == nil {
+		return list, nil
 	}
-	if db.ID != "" {
-		return
+
+	var wrapper struct {
+		Deployments []Deployment `json:"deployments"`
 	}
-	if id := databaseTypeSpecificID(*db, databaseType); id != "" {
-		db.ID = id
-		return
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.Deployments != nil {
+		return wrapper.Deployments, nil
 	}
-	db.ID = databaseAnyTypeID(*db)
-}
 
-func (c *DokployClient) CreateDatabase(projectID, environmentID, name, dbType, password, dockerImage string) (*Database, error) {
-	var endpoint string
-	payload := map[string]string{
-		"environmentId":    environmentID,
-		"name":             name,
-		"appName":          name,
-		"databaseName":     name,
-		"databasePassword": password,
-		"dockerImage":      dockerImage,
-	}
+	return nil, fmt.Errorf("failed to parse %s response", endpoint)
+}
 
-	switch dbType {
-	case "postgres":
-		endpoint = "postgres.create"
-		payload["databaseUser"] = "postgres"
-	case "mysql":
-		endpoint = "mysql.create"
-		payload["databaseUser"] = "root"
-	case "mariadb":
-		endpoint = "mariadb.create"
-		payload["databaseUser"] = "root"
-	case "mongo":
-		endpoint = "mongo.create"
-		payload["databaseUser"] = "mongo"
-	case "redis":
-		endpoint = "redis.create"
-		payload["databaseUser"] = "default"
+// Deploy queues a deployment of an application or a compose stack.
+func (c *DokployClient) Deploy(ctx context.Context, serviceType, serviceID string) error {
+	switch serviceType {
+	case "application":
+		return c.DeployApplication(ctx, serviceID)
+	case "compose":
+		return c.DeployCompose(ctx, serviceID)
 	default:
-		return nil, fmt.Errorf("unsupported database type: %s", dbType)
+		return fmt.Errorf("unsupported deployment service type: %s", serviceType)
 	}
+}
 
-	resp, err := c.doRequest("POST", endpoint, payload)
-	if err != nil {
-		return nil, err
-	}
+// WaitForNewDeployment polls the deployment history until a deployment whose
+// ID is not in known shows up and reaches a terminal status. The caller bounds
+// the wait through ctx.
+func (c *DokployClient) WaitForNewDeployment(ctx context.Context, serviceType, serviceID string, known map[string]bool, pollInterval time.Duration) (*Deployment, error) {
+	for {
+		deployments, err := c.ListDeployments(ctx, serviceType, serviceID)
+		if err != nil {
+			return nil, err
+		}
 
-	// Try to parse the response as a database object first.
-	var directResult Database
-	if err := json.Unmarshal(resp, &directResult); err == nil {
-		normalizeDatabaseID(&directResult, dbType)
-		if directResult.ID != "" {
-			if directResult.Type == "" {
-				directResult.Type = dbType
-			}
-			return &directResult, nil
+		if latest := latestDeployment(deployments, known); latest != nil && latest.Finished() {
+			return latest, nil
+		}
+
+		if err := Sleep(ctx, pollInterval); err != nil {
+			return nil, fmt.Errorf("waiting for %s %s deployment: %w", serviceType, serviceID, err)
 		}
 	}
+}
 
-	// Check if response is just "true" (boolean success indicator)
-	if string(resp) == "true" {
-		project, err := c.GetProject(projectID)
+// WaitForLatestDeployment polls the deployment history until the most recent
+// deployment reaches a terminal status. It returns nil when the service has
+// never been deployed.
+func (c *DokployClient) WaitForLatestDeployment(ctx context.Context, serviceType, serviceID string, pollInterval time.Duration) (*Deployment, error) {
+	for {
+		deployments, err := c.ListDeployments(ctx, serviceType, serviceID)
 		if err != nil {
-			return nil, fmt.Errorf("database created but failed to fetch project: %w", err)
-		}
-
-		for _, env := range project.Environments {
-			if env.ID == environmentID {
-				var dbs []Database
-				switch dbType {
-				case "postgres":
-					dbs = env.Postgres
-				case "mysql":
-					dbs = env.Mysql
-				case "mariadb":
-					dbs = env.Mariadb
-				case "mongo":
-					dbs = env.Mongo
-				case "redis":
-					dbs = env.Redis
-				}
+			return nil, err
+		}
 
-				for _, db := range dbs {
-					if db.Name == name || db.AppName == name {
-						id := databaseTypeSpecificID(db, dbType)
-						if id == "" {
-							id = databaseAnyTypeID(db)
-						}
-
-						// If no type-specific ID, try the generic databaseId field
-						if id == "" && db.ID != "" {
-							id = db.ID
-						}
-
-						// Create a result database with the ID explicitly set
-						result := Database{
-							ID:            id,
-							Name:          db.Name,
-							AppName:       db.AppName,
-							Type:          dbType,
-							ProjectID:     db.ProjectID,
-							EnvironmentID: db.EnvironmentID,
-							Version:       db.Version,
-							DockerImage:   db.DockerImage,
-							ExternalPort:  db.ExternalPort,
-							InternalPort:  db.InternalPort,
-							Password:      db.Password,
-							PostgresID:    db.PostgresID,
-							MysqlID:       db.MysqlID,
-							MariadbID:     db.MariadbID,
-							MongoID:       db.MongoID,
-							RedisID:       db.RedisID,
-						}
-						normalizeDatabaseID(&result, dbType)
-
-						if result.ID == "" {
-							return nil, fmt.Errorf("database created but ID not found (name: %s, postgresId: %s, databaseId: %s)", name, db.PostgresID, db.ID)
-						}
-
-						return &result, nil
-					}
-				}
-			}
+		latest := latestDeployment(deployments, nil)
+		if latest == nil || latest.Finished() {
+			return latest, nil
 		}
-		return nil, fmt.Errorf("database created but not found in project environments")
-	}
 
-	var wrapper struct {
-		Database Database `json:"database"`
-	}
-	if err := json.Unmarshal(resp, &wrapper); err == nil {
-		normalizeDatabaseID(&wrapper.Database, dbType)
-		if wrapper.Database.ID != "" {
-			if wrapper.Database.Type == "" {
-				wrapper.Database.Type = dbType
-			}
-			return &wrapper.Database, nil
+		if err := Sleep(ctx, pollInterval); err != nil {
+			return nil, fmt.Errorf("waiting for %s %s deployment: %w", serviceType, serviceID, err)
+		}
+	}
+}
+
+// Service statuses reported in applicationStatus and composeStatus. Dokploy
+// marks a service as running while a deployment is in progress and as done
+// once the deployed containers are up. Stopped services and services that
+// were never deployed are idle.
+const (
+	ServiceStatusIdle    = "idle"
+	ServiceStatusRunning = "running"
+	ServiceStatusDone    = "done"
+	ServiceStatusError   = "error"
+)
+
+// GetServiceStatus returns the applicationStatus or composeStatus of a
+// service. Databases are addressed by their engine type.
+func (c *DokployClient) GetServiceStatus(ctx context.Context, serviceType, serviceID string) (string, error) {
+	switch serviceType {
+	case "application":
+		app, err := c.GetApplication(ctx, serviceID)
+		if err != nil {
+			return "", err
+		}
+		return app.ApplicationStatus, nil
+	case "compose":
+		comp, err := c.GetCompose(ctx, serviceID)
+		if err != nil {
+			return "", err
+		}
+		return comp.ComposeStatus, nil
+	}
+	if _, err := databaseIDKey(serviceType); err != nil {
+		return "", fmt.Errorf("unsupported deployment service type: %s", serviceType)
+	}
+	db, err := c.GetDatabase(ctx, serviceID, serviceType)
+	if err != nil {
+		return "", err
+	}
+	return db.ApplicationStatus, nil
+}
+
+// StartService starts a stopped application, compose stack or database.
+// Databases are addressed by their engine type.
+func (c *DokployClient) StartService(ctx context.Context, serviceType, serviceID string) error {
+	switch serviceType {
+	case "application":
+		return c.StartApplication(ctx, serviceID)
+	case "compose":
+		return c.StartCompose(ctx, serviceID)
+	default:
+		return c.databaseAction(ctx, serviceID, serviceType, "start")
+	}
+}
+
+// StopService stops an application, compose stack or database.
+func (c *DokployClient) StopService(ctx context.Context, serviceType, serviceID string) error {
+	switch serviceType {
+	case "application":
+		return c.StopApplication(ctx, serviceID)
+	case "compose":
+		return c.StopCompose(ctx, serviceID)
+	default:
+		return c.databaseAction(ctx, serviceID, serviceType, "stop")
+	}
+}
+
+// WaitForServiceStatus polls the service until its status is done or error
+// and returns the last status seen. The caller bounds the wait through ctx.
+func (c *DokployClient) WaitForServiceStatus(ctx context.Context, serviceType, serviceID string, pollInterval time.Duration) (string, error) {
+	for {
+		status, err := c.GetServiceStatus(ctx, serviceType, serviceID)
+		if err != nil {
+			return "", err
+		}
+		if status == ServiceStatusDone || status == ServiceStatusError {
+			return status, nil
+		}
+
+		if err := Sleep(ctx, pollInterval); err != nil {
+			return status, fmt.Errorf("waiting for %s %s to report done (last status %q): %w", serviceType, serviceID, status, err)
+		}
+	}
+}
+
+// latestDeployment returns the most recently created deployment that is not
+// in known. Dokploy timestamps are RFC 3339 strings, so they sort lexically.
+func latestDeployment(deployments []Deployment, known map[string]bool) *Deployment {
+	var latest *Deployment
+	for i := range deployments {
+		deployment := &deployments[i]
+		if known[deployment.ID] {
+			continue
+		}
+		if latest == nil || deployment.CreatedAt > latest.CreatedAt {
+			latest = deployment
+		}
+	}
+	return latest
+}
+
+// ReadDeploymentLogTail returns up to maxLines trailing lines of a deployment
+// build log. Dokploy only streams logs over its listen-deployment websocket,
+// which tails the file and never closes, so reading stops once the stream has
+// been idle briefly.
+func (c *DokployClient) ReadDeploymentLogTail(ctx context.Context, logPath string, maxLines int) ([]string, error) {
+	wsURL, err := c.websocketURL("listen-deployment", url.Values{"logPath": {logPath}})
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := websocket.NewConfig(wsURL, c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	config.Header = http.Header{}
+	config.Header.Set("x-api-key", c.APIKey)
+
+	readCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	conn, err := config.DialContext(readCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open deployment log stream: %w", err)
+	}
+	defer conn.Close()
+
+	deadline, _ := readCtx.Deadline()
+	var lines []string
+	for {
+		idle := time.Now().Add(time.Second)
+		if idle.After(deadline) {
+			idle = deadline
+		}
+		if err := conn.SetReadDeadline(idle); err != nil {
+			break
+		}
+
+		var message string
+		if err := websocket.Message.Receive(conn, &message); err != nil {
+			break
+		}
+		lines = append(lines, strings.Split(strings.TrimRight(message, "\n"), "\n")...)
+		if len(lines) > maxLines {
+			lines = lines[len(lines)-maxLines:]
+		}
+	}
+
+	return lines, nil
+}
+
+func (c *DokployClient) websocketURL(path string, query url.Values) (string, error) {
+	u, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid host %q: %w", c.BaseURL, err)
+	}
+
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	default:
+		return "", fmt.Errorf("unsupported host scheme %q", u.Scheme)
+	}
+
+	// The API lives under /api while websockets are served from the root.
+	basePath := strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/api")
+	u.Path = basePath + "/" + path
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// --- Database ---
+
+type Database struct {
+	ID            string `json:"databaseId"`
+	Name          string `json:"name"`
+	AppName       string `json:"appName"`
+	Type          string `json:"type"`
+	ProjectID     string `json:"projectId"`
+	EnvironmentID string `json:"environmentId"`
+	Version       string `json:"version"`
+	DockerImage   string `json:"dockerImage"`
+	ExternalPort  int64  `json:"externalPort"`
+	InternalPort  int64  `json:"internalPort"`
+	Password      string `json:"password"`
+	PostgresID    string `json:"postgresId"`
+	MysqlID       string `json:"mysqlId"`
+	MariadbID     string `json:"mariadbId"`
+	MongoID       string `json:"mongoId"`
+	RedisID       string `json:"redisId"`
+	ServerID      string `json:"serverId"`
+	// DatabaseName is the database created inside the engine, which backups dump.
+	DatabaseName string           `json:"databaseName"`
+	Backups      []DatabaseBackup `json:"backups"`
+	Mounts       []Mount          `json:"mounts"`
+
+	// ApplicationStatus is the run state of the database, see ServiceStatusIdle.
+	ApplicationStatus string `json:"applicationStatus"`
+
+	// Credentials of the engine, as set when the database was created.
+	DatabaseUser     string `json:"databaseUser"`
+	DatabasePassword string `json:"databasePassword"`
+
+	// Container settings, applied the next time the database is deployed.
+	Command           *string `json:"command"`
+	Env               *string `json:"env"`
+	MemoryReservation *string `json:"memoryReservation"`
+	MemoryLimit       *string `json:"memoryLimit"`
+	CPUReservation    *string `json:"cpuReservation"`
+	CPULimit          *string `json:"cpuLimit"`
+}
+
+// DefaultDatabaseUser returns the user Dokploy creates a database of the
+// given engine type with when none is set.
+func DefaultDatabaseUser(dbType string) string {
+	switch dbType {
+	case "postgres":
+		return "postgres"
+	case "mysql", "mariadb":
+		return "root"
+	case "mongo":
+		return "mongo"
+	case "redis":
+		return "default"
+	default:
+		return ""
+	}
+}
+
+// databaseIDKey returns the payload key holding the ID of a database of the
+// given engine type.
+func databaseIDKey(dbType string) (string, error) {
+	switch dbType {
+	case "postgres":
+		return "postgresId", nil
+	case "mysql":
+		return "mysqlId", nil
+	case "mariadb":
+		return "mariadbId", nil
+	case "mongo":
+		return "mongoId", nil
+	case "redis":
+		return "redisId", nil
+	default:
+		return "", fmt.Errorf("unsupported database type: %s", dbType)
+	}
+}
+
+func databaseTypeSpecificID(db Database, databaseType string) string {
+	switch databaseType {
+	case "postgres":
+		return db.PostgresID
+	case "mysql":
+		return db.MysqlID
+	case "mariadb":
+		return db.MariadbID
+	case "mongo":
+		return db.MongoID
+	case "redis":
+		return db.RedisID
+	default:
+		return ""
+	}
+}
+
+func databaseAnyTypeID(db Database) string {
+	if db.PostgresID != "" {
+		return db.PostgresID
+	}
+	if db.MysqlID != "" {
+		return db.MysqlID
+	}
+	if db.MariadbID != "" {
+		return db.MariadbID
+	}
+	if db.MongoID != "" {
+		return db.MongoID
+	}
+	if db.RedisID != "" {
+		return db.RedisID
+	}
+	return ""
+}
+
+func normalizeDatabaseID(db *Database, databaseType string) {
+	if db == nil {
+		return
+	}
+	if db.ID != "" {
+		return
+	}
+	if id := databaseTypeSpecificID(*db, databaseType); id != "" {
+		db.ID = id
+		return
+	}
+	db.ID = databaseAnyTypeID(*db)
+}
+
+// CreateDatabase creates a database of db.Type. The database user defaults to
+// the engine's default user and the database name to the database's name.
+func (c *DokployClient) CreateDatabase(ctx context.Context, db Database) (*Database, error) {
+	if _, err := databaseIDKey(db.Type); err != nil {
+		return nil, err
+	}
+	dbType := db.Type
+	projectID := db.ProjectID
+	environmentID := db.EnvironmentID
+	name := db.Name
+
+	databaseUser := db.DatabaseUser
+	if databaseUser == "" {
+		databaseUser = DefaultDatabaseUser(dbType)
+	}
+	// Redis has no database inside the engine.
+	databaseName := db.DatabaseName
+	if databaseName == "" && dbType != "redis" {
+		databaseName = name
+	}
+
+	endpoint := dbType + ".create"
+	payload := map[string]string{
+		"environmentId":    environmentID,
+		"name":             name,
+		"appName":          name,
+		"databaseName":     databaseName,
+		"databaseUser":     databaseUser,
+		"databasePassword": db.DatabasePassword,
+		"dockerImage":      db.DockerImage,
+	}
+	if db.ServerID != "" {
+		payload["serverId"] = db.ServerID
+	}
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Try to parse the response as a database object first.
+	var directResult Database
+	if err := json.Unmarshal(resp, &directResult); err == nil {
+		normalizeDatabaseID(&directResult, dbType)
+		if directResult.ID != "" {
+			if directResult.Type == "" {
+				directResult.Type = dbType
+			}
+			return &directResult, nil
+		}
+	}
+
+	// Check if response is just "true" (boolean success indicator)
+	if string(resp) == "true" {
+		db, err := c.FindDatabaseByName(ctx, projectID, environmentID, dbType, name)
+		if err != nil {
+			return nil, fmt.Errorf("database created but lookup failed: %w", err)
+		}
+		return db, nil
+	}
+
+	var wrapper struct {
+		Database Database `json:"database"`
+	}
+	if err := json.Unmarshal(resp, &wrapper); err == nil {
+		normalizeDatabaseID(&wrapper.Database, dbType)
+		if wrapper.Database.ID != "" {
+			if wrapper.Database.Type == "" {
+				wrapper.Database.Type = dbType
+			}
+			return &wrapper.Database, nil
 		}
 	}
 
@@ -1480,7 +2644,43 @@ func (c *DokployClient) CreateDatabase(projectID, environmentID, name, dbType, p
 	return &result, nil
 }
 
-func (c *DokployClient) GetDatabase(dbID string, databaseType string) (*Database, error) {
+// FindDatabaseByName resolves a database of the given engine type by name or
+// appName inside a project environment.
+func (c *DokployClient) FindDatabaseByName(ctx context.Context, projectID, environmentID, dbType, name string) (*Database, error) {
+	project, err := c.GetProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch project: %w", err)
+	}
+
+	for _, env := range project.Environments {
+		if env.ID != environmentID {
+			continue
+		}
+		for _, db := range env.DatabasesOfType(dbType) {
+			if db.Name != name && db.AppName != name {
+				continue
+			}
+
+			result := db
+			result.ID = ""
+			result.Type = dbType
+			normalizeDatabaseID(&result, dbType)
+			// If no type-specific ID, try the generic databaseId field
+			if result.ID == "" {
+				result.ID = db.ID
+			}
+			if result.ID == "" {
+				return nil, fmt.Errorf("database found but ID not set (name: %s, postgresId: %s, databaseId: %s)", name, db.PostgresID, db.ID)
+			}
+			return &result, nil
+		}
+		return nil, fmt.Errorf("database %q of type %s not found in environment %s", name, dbType, environmentID)
+	}
+
+	return nil, fmt.Errorf("environment %s not found in project %s", environmentID, projectID)
+}
+
+func (c *DokployClient) GetDatabase(ctx context.Context, dbID string, databaseType string) (*Database, error) {
 	var endpoint string
 	switch databaseType {
 	case "postgres":
@@ -1497,7 +2697,7 @@ func (c *DokployClient) GetDatabase(dbID string, databaseType string) (*Database
 		return nil, fmt.Errorf("unsupported database type: %s", databaseType)
 	}
 
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1549,11 +2749,95 @@ func (c *DokployClient) GetDatabase(dbID string, databaseType string) (*Database
 	return &db, nil
 }
 
-func (c *DokployClient) DeleteDatabase(id string) error {
+// UpdateDatabase saves the name and container settings of a database with
+// <type>.update. Nil settings are left unchanged; empty strings clear them.
+func (c *DokployClient) UpdateDatabase(ctx context.Context, db Database) (*Database, error) {
+	idKey, err := databaseIDKey(db.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		idKey:         db.ID,
+		"name":        db.Name,
+		"dockerImage": db.DockerImage,
+	}
+	if db.Command != nil {
+		payload["command"] = *db.Command
+	}
+	if db.Env != nil {
+		payload["env"] = *db.Env
+	}
+	for key, value := range map[string]*string{
+		"memoryReservation": db.MemoryReservation,
+		"memoryLimit":       db.MemoryLimit,
+		"cpuReservation":    db.CPUReservation,
+		"cpuLimit":          db.CPULimit,
+	} {
+		if value != nil {
+			payload[key] = nullableString(*value)
+		}
+	}
+
+	if _, err := c.doRequest(ctx, "POST", db.Type+".update", payload); err != nil {
+		return nil, err
+	}
+	return c.GetDatabase(ctx, db.ID, db.Type)
+}
+
+// DeployDatabase creates or updates the database service with its current
+// settings and starts it.
+func (c *DokployClient) DeployDatabase(ctx context.Context, id, dbType string) error {
+	return c.databaseAction(ctx, id, dbType, "deploy")
+}
+
+// databaseAction calls <type>.<action> for actions that only take the ID.
+func (c *DokployClient) databaseAction(ctx context.Context, id, dbType, action string) error {
+	idKey, err := databaseIDKey(dbType)
+	if err != nil {
+		return err
+	}
+	payload := map[string]string{
+		idKey: id,
+	}
+	_, err = c.doRequest(ctx, "POST", dbType+"."+action, payload)
+	return err
+}
+
+// ChangeDatabasePassword sets a new password for the database user.
+func (c *DokployClient) ChangeDatabasePassword(ctx context.Context, id, dbType, password string) error {
+	idKey, err := databaseIDKey(dbType)
+	if err != nil {
+		return err
+	}
+	payload := map[string]string{
+		idKey:      id,
+		"password": password,
+	}
+	_, err = c.doRequest(ctx, "POST", dbType+".changePassword", payload)
+	return err
+}
+
+// SaveDatabaseExternalPort publishes the database on port, or stops publishing
+// it when port is nil.
+func (c *DokployClient) SaveDatabaseExternalPort(ctx context.Context, id, dbType string, port *int64) error {
+	idKey, err := databaseIDKey(dbType)
+	if err != nil {
+		return err
+	}
+	payload := map[string]interface{}{
+		idKey:          id,
+		"externalPort": port,
+	}
+	_, err = c.doRequest(ctx, "POST", dbType+".saveExternalPort", payload)
+	return err
+}
+
+func (c *DokployClient) DeleteDatabase(ctx context.Context, id string) error {
 	return fmt.Errorf("delete database requires type update")
 }
 
-func (c *DokployClient) DeleteDatabaseWithType(id, dbType string) error {
+func (c *DokployClient) DeleteDatabaseWithType(ctx context.Context, id, dbType string) error {
 	var endpoint string
 	var idKey string
 	switch dbType {
@@ -1579,7 +2863,7 @@ func (c *DokployClient) DeleteDatabaseWithType(id, dbType string) error {
 	payload := map[string]string{
 		idKey: id,
 	}
-	_, err := c.doRequest("POST", endpoint, payload)
+	_, err := c.doRequest(ctx, "POST", endpoint, payload)
 	return err
 }
 
@@ -1595,9 +2879,12 @@ type Domain struct {
 	Port            int64  `json:"port"`
 	HTTPS           bool   `json:"https"`
 	CertificateType string `json:"certificateType"`
+	// CustomCertResolver names the Traefik certificate resolver used when
+	// CertificateType is custom.
+	CustomCertResolver string `json:"customCertResolver"`
 }
 
-func (c *DokployClient) CreateDomain(domain Domain) (*Domain, error) {
+func (c *DokployClient) CreateDomain(ctx context.Context, domain Domain) (*Domain, error) {
 	payload := map[string]interface{}{
 		"host":            domain.Host,
 		"path":            domain.Path,
@@ -1614,8 +2901,11 @@ func (c *DokployClient) CreateDomain(domain Domain) (*Domain, error) {
 	if domain.ServiceName != "" {
 		payload["serviceName"] = domain.ServiceName
 	}
+	if domain.CustomCertResolver != "" {
+		payload["customCertResolver"] = domain.CustomCertResolver
+	}
 
-	resp, err := c.doRequest("POST", "domain.create", payload)
+	resp, err := c.doRequest(ctx, "POST", "domain.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -1634,35 +2924,35 @@ func (c *DokployClient) CreateDomain(domain Domain) (*Domain, error) {
 	return &result, nil
 }
 
-func (c *DokployClient) GetDomainsByApplication(appID string) ([]Domain, error) {
-	app, err := c.GetApplication(appID)
+func (c *DokployClient) GetDomainsByApplication(ctx context.Context, appID string) ([]Domain, error) {
+	app, err := c.GetApplication(ctx, appID)
 	if err != nil {
 		return nil, err
 	}
 	return app.Domains, nil
 }
 
-func (c *DokployClient) GetDomainsByCompose(composeID string) ([]Domain, error) {
-	comp, err := c.GetCompose(composeID)
+func (c *DokployClient) GetDomainsByCompose(ctx context.Context, composeID string) ([]Domain, error) {
+	comp, err := c.GetCompose(ctx, composeID)
 	if err != nil {
 		return nil, err
 	}
 	return comp.Domains, nil
 }
 
-func (c *DokployClient) DeleteDomain(id string) error {
+func (c *DokployClient) DeleteDomain(ctx context.Context, id string) error {
 	payload := map[string]string{
 		"domainId": id,
 	}
-	_, err := c.doRequest("POST", "domain.remove", payload)
+	_, err := c.doRequest(ctx, "POST", "domain.remove", payload)
 	return err
 }
 
-func (c *DokployClient) GenerateDomain(appName string) (string, error) {
+func (c *DokployClient) GenerateDomain(ctx context.Context, appName string) (string, error) {
 	payload := map[string]string{
 		"appName": appName,
 	}
-	resp, err := c.doRequest("POST", "domain.generateDomain", payload)
+	resp, err := c.doRequest(ctx, "POST", "domain.generateDomain", payload)
 	if err != nil {
 		return "", err
 	}
@@ -1680,7 +2970,7 @@ func (c *DokployClient) GenerateDomain(appName string) (string, error) {
 	return strings.Trim(string(resp), "\""), nil
 }
 
-func (c *DokployClient) UpdateDomain(domain Domain) (*Domain, error) {
+func (c *DokployClient) UpdateDomain(ctx context.Context, domain Domain) (*Domain, error) {
 	payload := map[string]interface{}{
 		"domainId":        domain.ID,
 		"host":            domain.Host,
@@ -1689,8 +2979,10 @@ func (c *DokployClient) UpdateDomain(domain Domain) (*Domain, error) {
 		"https":           domain.HTTPS,
 		"certificateType": domain.CertificateType,
 		"serviceName":     domain.ServiceName,
+		// A null resolver detaches the one set previously.
+		"customCertResolver": nullableString(domain.CustomCertResolver),
 	}
-	resp, err := c.doRequest("POST", "domain.update", payload)
+	resp, err := c.doRequest(ctx, "POST", "domain.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -1720,7 +3012,7 @@ type Port struct {
 	PublishMode   string `json:"publishMode"`
 }
 
-func (c *DokployClient) CreatePort(port Port) (*Port, error) {
+func (c *DokployClient) CreatePort(ctx context.Context, port Port) (*Port, error) {
 	payload := map[string]interface{}{
 		"applicationId": port.ApplicationID,
 		"publishedPort": port.PublishedPort,
@@ -1733,7 +3025,7 @@ func (c *DokployClient) CreatePort(port Port) (*Port, error) {
 		payload["publishMode"] = port.PublishMode
 	}
 
-	resp, err := c.doRequest("POST", "port.create", payload)
+	resp, err := c.doRequest(ctx, "POST", "port.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -1753,14 +3045,14 @@ func (c *DokployClient) CreatePort(port Port) (*Port, error) {
 	// Dokploy sometimes returns a bare boolean on successful writes.
 	// In that case resolve the newly created port by matching its signature.
 	if string(resp) == "true" {
-		return c.findPortBySignature(port.ApplicationID, port.PublishedPort, port.TargetPort, port.Protocol, port.PublishMode)
+		return c.findPortBySignature(ctx, port.ApplicationID, port.PublishedPort, port.TargetPort, port.Protocol, port.PublishMode)
 	}
 
-	return c.findPortBySignature(port.ApplicationID, port.PublishedPort, port.TargetPort, port.Protocol, port.PublishMode)
+	return c.findPortBySignature(ctx, port.ApplicationID, port.PublishedPort, port.TargetPort, port.Protocol, port.PublishMode)
 }
 
-func (c *DokployClient) findPortBySignature(applicationID string, publishedPort, targetPort int64, protocol, publishMode string) (*Port, error) {
-	app, err := c.GetApplication(applicationID)
+func (c *DokployClient) findPortBySignature(ctx context.Context, applicationID string, publishedPort, targetPort int64, protocol, publishMode string) (*Port, error) {
+	app, err := c.GetApplication(ctx, applicationID)
 	if err != nil {
 		return nil, fmt.Errorf("port created but failed to fetch application: %w", err)
 	}
@@ -1787,9 +3079,9 @@ func (c *DokployClient) findPortBySignature(applicationID string, publishedPort,
 	)
 }
 
-func (c *DokployClient) GetPort(id string) (*Port, error) {
+func (c *DokployClient) GetPort(ctx context.Context, id string) (*Port, error) {
 	endpoint := fmt.Sprintf("port.one?portId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1811,7 +3103,7 @@ func (c *DokployClient) GetPort(id string) (*Port, error) {
 	return &result, nil
 }
 
-func (c *DokployClient) UpdatePort(port Port) (*Port, error) {
+func (c *DokployClient) UpdatePort(ctx context.Context, port Port) (*Port, error) {
 	payload := map[string]interface{}{
 		"portId":        port.ID,
 		"publishedPort": port.PublishedPort,
@@ -1824,7 +3116,7 @@ func (c *DokployClient) UpdatePort(port Port) (*Port, error) {
 		payload["publishMode"] = port.PublishMode
 	}
 
-	resp, err := c.doRequest("POST", "port.update", payload)
+	resp, err := c.doRequest(ctx, "POST", "port.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -1842,23 +3134,187 @@ func (c *DokployClient) UpdatePort(port Port) (*Port, error) {
 	}
 
 	if string(resp) == "true" {
-		return c.GetPort(port.ID)
+		return c.GetPort(ctx, port.ID)
 	}
 
-	return c.GetPort(port.ID)
+	return c.GetPort(ctx, port.ID)
 }
 
-func (c *DokployClient) DeletePort(id string) error {
+func (c *DokployClient) DeletePort(ctx context.Context, id string) error {
 	payload := map[string]string{
 		"portId": id,
 	}
-	_, err := c.doRequest("POST", "port.delete", payload)
+	_, err := c.doRequest(ctx, "POST", "port.delete", payload)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// --- Redirect ---
+
+// Redirect is a regex redirect rule of an application, applied by Traefik.
+type Redirect struct {
+	ID            string `json:"redirectId"`
+	ApplicationID string `json:"applicationId"`
+	Regex         string `json:"regex"`
+	Replacement   string `json:"replacement"`
+	Permanent     bool   `json:"permanent"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+func redirectPayload(redirect Redirect) map[string]interface{} {
+	return map[string]interface{}{
+		"regex":       redirect.Regex,
+		"replacement": redirect.Replacement,
+		"permanent":   redirect.Permanent,
+	}
+}
+
+func (c *DokployClient) CreateRedirect(ctx context.Context, redirect Redirect) (*Redirect, error) {
+	payload := redirectPayload(redirect)
+	payload["applicationId"] = redirect.ApplicationID
+
+	resp, err := c.doRequest(ctx, "POST", "redirects.create", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Redirect
+	if err := json.Unmarshal(resp, &result); err == nil && result.ID != "" {
+		return &result, nil
+	}
+
+	// Dokploy returns a bare boolean on success, so resolve the new redirect
+	// from the application.
+	return c.findRedirectBySignature(ctx, redirect)
+}
+
+// findRedirectBySignature returns the most recently created redirect of the
+// application with the same rule.
+func (c *DokployClient) findRedirectBySignature(ctx context.Context, redirect Redirect) (*Redirect, error) {
+	redirects, err := c.ListRedirects(ctx, redirect.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("redirect created but failed to fetch application: %w", err)
+	}
+
+	var found *Redirect
+	for i, existing := range redirects {
+		if existing.Regex != redirect.Regex || existing.Replacement != redirect.Replacement || existing.Permanent != redirect.Permanent {
+			continue
+		}
+		if found == nil || existing.CreatedAt > found.CreatedAt {
+			found = &redirects[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("redirect created but not found on application %s (regex=%q)", redirect.ApplicationID, redirect.Regex)
+	}
+	return found, nil
+}
+
+// ListRedirects returns the redirects of an application.
+func (c *DokployClient) ListRedirects(ctx context.Context, applicationID string) ([]Redirect, error) {
+	app, err := c.GetApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	return app.Redirects, nil
+}
+
+func (c *DokployClient) UpdateRedirect(ctx context.Context, redirect Redirect) error {
+	payload := redirectPayload(redirect)
+	payload["redirectId"] = redirect.ID
+
+	_, err := c.doRequest(ctx, "POST", "redirects.update", payload)
+	return err
+}
+
+func (c *DokployClient) DeleteRedirect(ctx context.Context, id string) error {
+	payload := map[string]string{
+		"redirectId": id,
+	}
+	_, err := c.doRequest(ctx, "POST", "redirects.delete", payload)
+	return err
+}
+
+// --- Security ---
+
+// Security is a basic-auth credential protecting an application, enforced by
+// Traefik in front of its domains.
+type Security struct {
+	ID            string `json:"securityId"`
+	ApplicationID string `json:"applicationId"`
+	Username      string `json:"username"`
+	Password      string `json:"password"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+func (c *DokployClient) CreateSecurity(ctx context.Context, security Security) (*Security, error) {
+	payload := map[string]string{
+		"applicationId": security.ApplicationID,
+		"username":      security.Username,
+		"password":      security.Password,
+	}
+
+	resp, err := c.doRequest(ctx, "POST", "security.create", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Security
+	if err := json.Unmarshal(resp, &result); err == nil && result.ID != "" {
+		return &result, nil
+	}
+
+	// Dokploy returns a bare boolean on success. Usernames are unique per
+	// application, so the new entry is resolved by username.
+	entries, err := c.ListSecurity(ctx, security.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("basic auth created but failed to fetch application: %w", err)
+	}
+	if found := findSecurityByUsername(entries, security.Username); found != nil {
+		return found, nil
+	}
+	return nil, fmt.Errorf("basic auth created but not found on application %s (username=%q)", security.ApplicationID, security.Username)
+}
+
+func findSecurityByUsername(entries []Security, username string) *Security {
+	for i := range entries {
+		if entries[i].Username == username {
+			return &entries[i]
+		}
+	}
+	return nil
+}
+
+// ListSecurity returns the basic-auth credentials of an application.
+func (c *DokployClient) ListSecurity(ctx context.Context, applicationID string) ([]Security, error) {
+	app, err := c.GetApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	return app.Security, nil
+}
+
+func (c *DokployClient) UpdateSecurity(ctx context.Context, security Security) error {
+	payload := map[string]string{
+		"securityId": security.ID,
+		"username":   security.Username,
+		"password":   security.Password,
+	}
+	_, err := c.doRequest(ctx, "POST", "security.update", payload)
+	return err
+}
+
+func (c *DokployClient) DeleteSecurity(ctx context.Context, id string) error {
+	payload := map[string]string{
+		"securityId": id,
+	}
+	_, err := c.doRequest(ctx, "POST", "security.delete", payload)
+	return err
+}
+
 // --- Environment Variable ---
 
 type EnvironmentVariable struct {
@@ -1869,301 +3325,844 @@ type EnvironmentVariable struct {
 	Scope         string `json:"scope"`
 }
 
-func (c *DokployClient) UpdateApplicationEnv(appID string, updateFn func(envMap map[string]string), createEnvFile *bool) error {
-	var lastErr error
-	for i := 0; i < 5; i++ { // Retry up to 5 times
-		app, err := c.GetApplication(appID)
-		if err != nil {
+func (c *DokployClient) UpdateApplicationEnv(ctx context.Context, appID string, updateFn func(envMap map[string]string), createEnvFile *bool) error {
+	return c.applyEnvUpdate(ctx, updateFn,
+		func() (string, error) {
+			app, err := c.GetApplication(ctx, appID)
+			if err != nil {
+				return "", err
+			}
+			return app.Env, nil
+		},
+		func(env string) error {
+			payload := map[string]interface{}{
+				"applicationId": appID,
+				"env":           env,
+			}
+			if createEnvFile != nil {
+				payload["createEnvFile"] = *createEnvFile
+			}
+			_, err := c.doRequest(ctx, "POST", "application.saveEnvironment", payload)
 			return err
-		}
+		},
+	)
+}
+
+func (c *DokployClient) UpdateComposeEnv(ctx context.Context, composeID string, updateFn func(envMap map[string]string), _ *bool) error {
+	return c.applyEnvUpdate(ctx, updateFn,
+		func() (string, error) {
+			comp, err := c.GetCompose(ctx, composeID)
+			if err != nil {
+				return "", err
+			}
+			return comp.Env, nil
+		},
+		func(env string) error {
+			payload := map[string]interface{}{
+				"composeId": composeID,
+				"env":       env,
+			}
+			_, err := c.doRequest(ctx, "POST", "compose.update", payload)
+			return err
+		},
+	)
+}
+
+func (c *DokployClient) CreateVariable(ctx context.Context, appID, key, value, scope string, createEnvFile *bool) (*EnvironmentVariable, error) {
+	err := c.UpdateApplicationEnv(ctx, appID, func(envMap map[string]string) {
+		envMap[key] = value
+	}, createEnvFile)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &EnvironmentVariable{
+		ID:            appID + "_" + key,
+		ApplicationID: appID,
+		Key:           key,
+		Value:         value,
+		Scope:         scope,
+	}, nil
+}
+
+func (c *DokployClient) GetVariablesByApplication(ctx context.Context, appID string) ([]EnvironmentVariable, error) {
+	app, err := c.GetApplication(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	envMap := ParseEnv(app.Env)
+	var vars []EnvironmentVariable
+	for k, v := range envMap {
+		vars = append(vars, EnvironmentVariable{
+			ID:            appID + "_" + k,
+			ApplicationID: appID,
+			Key:           k,
+			Value:         v,
+			Scope:         "runtime",
+		})
+	}
+	return vars, nil
+}
+
+func (c *DokployClient) DeleteVariable(ctx context.Context, id string, createEnvFile *bool) error {
+	parts := strings.SplitN(id, "_", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid variable ID format")
+	}
+	appID, key := parts[0], parts[1]
+
+	return c.UpdateApplicationEnv(ctx, appID, func(envMap map[string]string) {
+		delete(envMap, key)
+	}, createEnvFile)
+}
+
+// ParseEnv returns the variables of a dotenv document. See EnvFile for the
+// supported syntax.
+func ParseEnv(env string) map[string]string {
+	return ParseEnvFile(env).Map()
+}
+
+// FormatEnv renders a map in the KEY=value format used by Dokploy for env,
+// build args and build secrets. Keys are sorted so the output is stable and
+// values are quoted where needed. It returns an error for values that cannot
+// be written so that they parse back unchanged.
+func FormatEnv(m map[string]string) (string, error) {
+	f := &EnvFile{}
+	if err := f.Replace(m); err != nil {
+		return "", err
+	}
+	return f.String(), nil
+}
+
+// --- SSH Key ---
+
+type SSHKey struct {
+	ID          string `json:"sshKeyId"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	PrivateKey  string `json:"privateKey"`
+	PublicKey   string `json:"publicKey"`
+}
+
+func (c *DokployClient) CreateSSHKey(ctx context.Context, name, description, privateKey, publicKey string) (*SSHKey, error) {
+	// Fetch user to get Organization ID
+	user, err := c.GetUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for organization ID: %w", err)
+	}
+
+	payload := map[string]string{
+		"name":           name,
+		"description":    description,
+		"privateKey":     privateKey,
+		"publicKey":      publicKey,
+		"organizationId": user.OrganizationID,
+	}
+
+	resp, err := c.doRequest(ctx, "POST", "sshKey.create", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle empty response or boolean by fetching list
+	if len(resp) == 0 || string(resp) == "true" {
+		return c.findSSHKeyByName(ctx, name)
+	}
+
+	var wrapper struct {
+		SSHKey SSHKey `json:"sshKey"`
+	}
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.SSHKey.ID != "" {
+		return &wrapper.SSHKey, nil
+	}
 
-		envMap := ParseEnv(app.Env)
-		originalEnvStr := app.Env
+	var result SSHKey
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	if result.ID == "" {
+		return c.findSSHKeyByName(ctx, name)
+	}
+
+	// Fallback to list lookup if unmarshal failed to produce ID
+	return &result, nil
+}
+
+func (c *DokployClient) ListSSHKeys(ctx context.Context) ([]SSHKey, error) {
+	resp, err := c.doRequest(ctx, "GET", "sshKey.all", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapper struct {
+		SSHKeys []SSHKey `json:"sshKeys"` // Guessing wrapper
+	}
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.SSHKeys != nil {
+		return wrapper.SSHKeys, nil
+	}
 
-		updateFn(envMap) // Modify the map
+	var list []SSHKey
+	if err := json.Unmarshal(resp, &list); err == nil {
+		return list, nil
+	}
 
-		newEnvStr := formatEnv(envMap)
+	return nil, fmt.Errorf("failed to parse sshKey.all response")
+}
 
-		if newEnvStr == originalEnvStr {
-			return nil // No changes to be made
+func (c *DokployClient) findSSHKeyByName(ctx context.Context, name string) (*SSHKey, error) {
+	keys, err := c.ListSSHKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ssh key created but failed to list keys: %w", err)
+	}
+	for _, key := range keys {
+		if key.Name == name {
+			return &key, nil
 		}
+	}
+	return nil, fmt.Errorf("ssh key created but not found in list by name: %s", name)
+}
 
-		payload := map[string]interface{}{
-			"applicationId": appID,
-			"env":           newEnvStr,
+func (c *DokployClient) GetSSHKey(ctx context.Context, id string) (*SSHKey, error) {
+	endpoint := fmt.Sprintf("sshKey.one?sshKeyId=%s", id)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result SSHKey
+	if err := json.Unmarshal(resp, &result); err != nil {
+		// Try wrapper?
+		var wrapper struct {
+			SSHKey SSHKey `json:"sshKey"`
 		}
-		if createEnvFile != nil {
-			payload["createEnvFile"] = *createEnvFile
+		if err2 := json.Unmarshal(resp, &wrapper); err2 == nil {
+			return &wrapper.SSHKey, nil
 		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *DokployClient) DeleteSSHKey(ctx context.Context, id string) error {
+	payload := map[string]string{
+		"sshKeyId": id,
+	}
+	_, err := c.doRequest(ctx, "POST", "sshKey.remove", payload)
+	return err
+}
+
+// --- Certificate ---
+
+// Certificate is a custom TLS certificate uploaded to Dokploy. Traefik serves
+// it for the domains it covers.
+type Certificate struct {
+	ID              string `json:"certificateId"`
+	Name            string `json:"name"`
+	CertificateData string `json:"certificateData"`
+	PrivateKey      string `json:"privateKey"`
+	CertificatePath string `json:"certificatePath"`
+	AutoRenew       bool   `json:"autoRenew"`
+	ServerID        string `json:"serverId"`
+}
+
+func (c *DokployClient) CreateCertificate(ctx context.Context, certificate Certificate) (*Certificate, error) {
+	user, err := c.GetUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for organization ID: %w", err)
+	}
+
+	payload := map[string]interface{}{
+		"name":            certificate.Name,
+		"certificateData": certificate.CertificateData,
+		"privateKey":      certificate.PrivateKey,
+		"autoRenew":       certificate.AutoRenew,
+		"organizationId":  user.OrganizationID,
+	}
+	if certificate.ServerID != "" {
+		payload["serverId"] = certificate.ServerID
+	}
+
+	resp, err := c.doRequest(ctx, "POST", "certificates.create", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Certificate
+	if err := json.Unmarshal(resp, &result); err == nil && result.ID != "" {
+		return &result, nil
+	}
+	return c.findCertificateByName(ctx, certificate.Name)
+}
+
+func (c *DokployClient) findCertificateByName(ctx context.Context, name string) (*Certificate, error) {
+	certificates, err := c.ListCertificates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("certificate created but failed to list certificates: %w", err)
+	}
+	for i := range certificates {
+		if certificates[i].Name == name {
+			return &certificates[i], nil
+		}
+	}
+	return nil, fmt.Errorf("certificate created but not found by name %q", name)
+}
+
+func (c *DokployClient) ListCertificates(ctx context.Context) ([]Certificate, error) {
+	resp, err := c.doRequest(ctx, "GET", "certificates.all", nil)
+	if err != nil {
+		return nil, err
+	}
+	var result []Certificate
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *DokployClient) GetCertificate(ctx context.Context, id string) (*Certificate, error) {
+	endpoint := fmt.Sprintf("certificates.one?certificateId=%s", id)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result Certificate
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *DokployClient) DeleteCertificate(ctx context.Context, id string) error {
+	payload := map[string]string{
+		"certificateId": id,
+	}
+	_, err := c.doRequest(ctx, "POST", "certificates.remove", payload)
+	return err
+}
+
+// --- Server ---
+
+// Server is a remote machine registered in Dokploy that builds or runs
+// workloads.
+type Server struct {
+	ID           string `json:"serverId"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	IPAddress    string `json:"ipAddress"`
+	Port         int64  `json:"port"`
+	Username     string `json:"username"`
+	SSHKeyID     string `json:"sshKeyId"`
+	ServerType   string `json:"serverType"`
+	AppName      string `json:"appName"`
+	ServerStatus string `json:"serverStatus"`
+}
+
+func serverPayload(server Server) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        server.Name,
+		"description": server.Description,
+		"ipAddress":   server.IPAddress,
+		"port":        server.Port,
+		"username":    server.Username,
+		"sshKeyId":    server.SSHKeyID,
+		"serverType":  server.ServerType,
+	}
+}
+
+func (c *DokployClient) CreateServer(ctx context.Context, server Server) (*Server, error) {
+	resp, err := c.doRequest(ctx, "POST", "server.create", serverPayload(server))
+	if err != nil {
+		return nil, err
+	}
+
+	var result Server
+	if err := json.Unmarshal(resp, &result); err == nil && result.ID != "" {
+		return &result, nil
+	}
+
+	var wrapper struct {
+		Server Server `json:"server"`
+	}
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.Server.ID != "" {
+		return &wrapper.Server, nil
+	}
+
+	// Older Dokploy versions only acknowledge the mutation.
+	servers, err := c.ListServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("server created but failed to list servers: %w", err)
+	}
+	for i := range servers {
+		if servers[i].Name == server.Name && servers[i].IPAddress == server.IPAddress {
+			return &servers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("server created but not found in list by name: %s", server.Name)
+}
+
+func (c *DokployClient) ListServers(ctx context.Context) ([]Server, error) {
+	resp, err := c.doRequest(ctx, "GET", "server.all", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []Server
+	if err := json.Unmarshal(resp, &list); err == nil {
+		return list, nil
+	}
+
+	var wrapper struct {
+		Servers []Server `json:"servers"`
+	}
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.Servers != nil {
+		return wrapper.Servers, nil
+	}
+
+	return nil, fmt.Errorf("failed to parse server.all response")
+}
 
-		_, err = c.doRequest("POST", "application.saveEnvironment", payload)
-		if err != nil {
-			lastErr = err
-			time.Sleep(time.Duration(100*(i+1)) * time.Millisecond) // Backoff
-			continue
-		}
+func (c *DokployClient) GetServer(ctx context.Context, id string) (*Server, error) {
+	endpoint := fmt.Sprintf("server.one?serverId=%s", id)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
 
-		// Verify write
-		verifyApp, err := c.GetApplication(appID)
-		if err != nil {
-			// If we can't verify, we have to assume it worked or retry
-			lastErr = fmt.Errorf("failed to verify environment update: %w", err)
-			time.Sleep(time.Duration(100*(i+1)) * time.Millisecond)
-			continue
-		}
-		if verifyApp.Env == newEnvStr {
-			return nil // Success
-		}
-		lastErr = fmt.Errorf("environment update conflict, retrying")
+	var result Server
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
 	}
-	return lastErr
+	return &result, nil
 }
 
-func (c *DokployClient) UpdateComposeEnv(composeID string, updateFn func(envMap map[string]string), _ *bool) error {
-	var lastErr error
-	for i := 0; i < 5; i++ { // Retry up to 5 times
-		comp, err := c.GetCompose(composeID)
-		if err != nil {
-			return err
-		}
+func (c *DokployClient) UpdateServer(ctx context.Context, server Server) (*Server, error) {
+	payload := serverPayload(server)
+	payload["serverId"] = server.ID
 
-		envMap := ParseEnv(comp.Env)
-		originalEnvStr := comp.Env
+	if _, err := c.doRequest(ctx, "POST", "server.update", payload); err != nil {
+		return nil, err
+	}
+	return c.GetServer(ctx, server.ID)
+}
 
-		updateFn(envMap) // Modify the map
+func (c *DokployClient) DeleteServer(ctx context.Context, id string) error {
+	payload := map[string]string{
+		"serverId": id,
+	}
+	_, err := c.doRequest(ctx, "POST", "server.remove", payload)
+	return err
+}
 
-		newEnvStr := formatEnv(envMap)
+// SetupServer runs Dokploy's server setup, which installs Docker, Swarm,
+// Traefik and the build tools over SSH. Dokploy performs the setup inside the
+// request, which routinely outlives the HTTP timeout or that of a proxy in
+// front of Dokploy. Those timeouts do not stop the setup, so they are not
+// reported; callers should poll ValidateServer to learn the outcome.
+func (c *DokployClient) SetupServer(ctx context.Context, id string) error {
+	payload := map[string]string{
+		"serverId": id,
+	}
+	_, err := c.doRequest(ctx, "POST", "server.setup", payload)
+	if err == nil || ctx.Err() != nil {
+		return err
+	}
 
-		if newEnvStr == originalEnvStr {
-			return nil // No changes to be made
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		tflog.Debug(ctx, "Server setup request timed out, setup continues on the Dokploy server", map[string]interface{}{"server_id": id})
+		return nil
+	}
+	for _, apiErr := range apiErrors(err) {
+		if apiErr.StatusCode == http.StatusGatewayTimeout {
+			tflog.Debug(ctx, "Server setup request timed out at the gateway, setup continues on the Dokploy server", map[string]interface{}{"server_id": id})
+			return nil
 		}
+	}
+	return err
+}
 
-		payload := map[string]interface{}{
-			"composeId": composeID,
-			"env":       newEnvStr,
-		}
+// ServerValidation reports which parts of the server setup are in place.
+type ServerValidation struct {
+	Docker struct {
+		Enabled bool   `json:"enabled"`
+		Version string `json:"version"`
+	} `json:"docker"`
+	IsDokployNetworkInstalled bool `json:"isDokployNetworkInstalled"`
+	IsSwarmInstalled          bool `json:"isSwarmInstalled"`
+	IsMainDirectoryInstalled  bool `json:"isMainDirectoryInstalled"`
+}
 
-		_, err = c.doRequest("POST", "compose.update", payload)
-		if err != nil {
-			lastErr = err
-			time.Sleep(time.Duration(100*(i+1)) * time.Millisecond) // Backoff
-			continue
-		}
+// Ready reports whether the server can take workloads. Build servers only
+// need Docker and the Dokploy directory; deploy servers also join Swarm and
+// the dokploy-network.
+func (v ServerValidation) Ready(serverType string) bool {
+	if !v.Docker.Enabled || !v.IsMainDirectoryInstalled {
+		return false
+	}
+	if serverType == "build" {
+		return true
+	}
+	return v.IsSwarmInstalled && v.IsDokployNetworkInstalled
+}
 
-		// Verify write
-		verifyComp, err := c.GetCompose(composeID)
-		if err != nil {
-			// If we can't verify, we have to assume it worked or retry
-			lastErr = fmt.Errorf("failed to verify environment update: %w", err)
-			time.Sleep(time.Duration(100*(i+1)) * time.Millisecond)
-			continue
+// Missing lists the setup steps that are not in place yet.
+func (v ServerValidation) Missing(serverType string) []string {
+	var missing []string
+	if !v.Docker.Enabled {
+		missing = append(missing, "docker")
+	}
+	if !v.IsMainDirectoryInstalled {
+		missing = append(missing, "main directory")
+	}
+	if serverType != "build" {
+		if !v.IsSwarmInstalled {
+			missing = append(missing, "swarm")
 		}
-		if verifyComp.Env == newEnvStr {
-			return nil // Success
+		if !v.IsDokployNetworkInstalled {
+			missing = append(missing, "dokploy-network")
 		}
-		lastErr = fmt.Errorf("environment update conflict, retrying")
 	}
-	return lastErr
+	return missing
 }
 
-func (c *DokployClient) CreateVariable(appID, key, value, scope string, createEnvFile *bool) (*EnvironmentVariable, error) {
-	err := c.UpdateApplicationEnv(appID, func(envMap map[string]string) {
-		envMap[key] = value
-	}, createEnvFile)
-
+func (c *DokployClient) ValidateServer(ctx context.Context, id string) (*ServerValidation, error) {
+	endpoint := fmt.Sprintf("server.validate?serverId=%s", id)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return &EnvironmentVariable{
-		ID:            appID + "_" + key,
-		ApplicationID: appID,
-		Key:           key,
-		Value:         value,
-		Scope:         scope,
-	}, nil
-}
-
-func (c *DokployClient) GetVariablesByApplication(appID string) ([]EnvironmentVariable, error) {
-	app, err := c.GetApplication(appID)
-	if err != nil {
+	var result ServerValidation
+	if err := json.Unmarshal(resp, &result); err != nil {
 		return nil, err
 	}
-	envMap := ParseEnv(app.Env)
-	var vars []EnvironmentVariable
-	for k, v := range envMap {
-		vars = append(vars, EnvironmentVariable{
-			ID:            appID + "_" + k,
-			ApplicationID: appID,
-			Key:           k,
-			Value:         v,
-			Scope:         "runtime",
-		})
-	}
-	return vars, nil
+	return &result, nil
 }
 
-func (c *DokployClient) DeleteVariable(id string, createEnvFile *bool) error {
-	parts := strings.SplitN(id, "_", 2)
-	if len(parts) != 2 {
-		return fmt.Errorf("invalid variable ID format")
+// isClientAPIError reports whether err carries a 4xx response, which polling
+// again will not fix.
+func isClientAPIError(err error) bool {
+	for _, apiErr := range apiErrors(err) {
+		if apiErr.StatusCode >= 400 && apiErr.StatusCode < 500 {
+			return true
+		}
 	}
-	appID, key := parts[0], parts[1]
-
-	return c.UpdateApplicationEnv(appID, func(envMap map[string]string) {
-		delete(envMap, key)
-	}, createEnvFile)
+	return false
 }
 
-func ParseEnv(env string) map[string]string {
-	m := make(map[string]string)
-	if env == "" {
-		return m
-	}
-	lines := strings.Split(env, "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" || strings.HasPrefix(line, "#") {
-			continue
+// WaitForServerSetup polls ValidateServer until the server is ready. The
+// caller bounds the wait through ctx; on timeout the last validation result
+// is returned alongside the error.
+func (c *DokployClient) WaitForServerSetup(ctx context.Context, id, serverType string, pollInterval time.Duration) (*ServerValidation, error) {
+	var last *ServerValidation
+	for {
+		validation, err := c.ValidateServer(ctx, id)
+		switch {
+		case err == nil:
+			last = validation
+			if validation.Ready(serverType) {
+				return validation, nil
+			}
+		case ctx.Err() != nil || isClientAPIError(err):
+			return last, err
+		default:
+			// The server may be unreachable while Docker restarts during
+			// setup; keep polling until the deadline.
+			tflog.Debug(ctx, "Server validation failed, retrying", map[string]interface{}{"server_id": id, "error": err.Error()})
 		}
-		parts := strings.SplitN(line, "=", 2)
-		if len(parts) == 2 {
-			m[parts[0]] = parts[1]
+
+		if err := Sleep(ctx, pollInterval); err != nil {
+			return last, fmt.Errorf("waiting for server %s setup: %w", id, err)
 		}
 	}
-	return m
 }
 
-func formatEnv(m map[string]string) string {
-	var lines []string
-	for k, v := range m {
-		lines = append(lines, fmt.Sprintf("%s=%s", k, v))
-	}
-	return strings.Join(lines, "\n")
+// --- Registry ---
+
+// Registry is a container registry whose credentials Dokploy uses to pull
+// images and to push images built for multi-server deployments.
+type Registry struct {
+	ID           string `json:"registryId"`
+	Name         string `json:"registryName"`
+	RegistryURL  string `json:"registryUrl"`
+	Username     string `json:"username"`
+	Password     string `json:"password"`
+	ImagePrefix  string `json:"imagePrefix"`
+	RegistryType string `json:"registryType"`
+	ServerID     string `json:"serverId"`
 }
 
-// --- SSH Key ---
-
-type SSHKey struct {
-	ID          string `json:"sshKeyId"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	PrivateKey  string `json:"privateKey"`
-	PublicKey   string `json:"publicKey"`
+func registryPayload(registry Registry) map[string]interface{} {
+	registryType := registry.RegistryType
+	if registryType == "" {
+		registryType = "cloud"
+	}
+	payload := map[string]interface{}{
+		"registryName": registry.Name,
+		"registryUrl":  registry.RegistryURL,
+		"username":     registry.Username,
+		"password":     registry.Password,
+		"imagePrefix":  registry.ImagePrefix,
+		"registryType": registryType,
+	}
+	if registry.ServerID != "" {
+		payload["serverId"] = registry.ServerID
+	}
+	return payload
 }
 
-func (c *DokployClient) CreateSSHKey(name, description, privateKey, publicKey string) (*SSHKey, error) {
-	// Fetch user to get Organization ID
-	user, err := c.GetUser()
+func (c *DokployClient) CreateRegistry(ctx context.Context, registry Registry) (*Registry, error) {
+	resp, err := c.doRequest(ctx, "POST", "registry.create", registryPayload(registry))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user for organization ID: %w", err)
+		return nil, err
 	}
 
-	payload := map[string]string{
-		"name":           name,
-		"description":    description,
-		"privateKey":     privateKey,
-		"publicKey":      publicKey,
-		"organizationId": user.OrganizationID,
+	var result Registry
+	if err := json.Unmarshal(resp, &result); err == nil && result.ID != "" {
+		return &result, nil
+	}
+
+	var wrapper struct {
+		Registry Registry `json:"registry"`
+	}
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.Registry.ID != "" {
+		return &wrapper.Registry, nil
+	}
+
+	registries, err := c.ListRegistries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("registry created but failed to list registries: %w", err)
+	}
+	for i := range registries {
+		if registries[i].Name == registry.Name {
+			return &registries[i], nil
+		}
 	}
+	return nil, fmt.Errorf("registry created but not found in list by name: %s", registry.Name)
+}
 
-	resp, err := c.doRequest("POST", "sshKey.create", payload)
+func (c *DokployClient) ListRegistries(ctx context.Context) ([]Registry, error) {
+	resp, err := c.doRequest(ctx, "GET", "registry.all", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	// Handle empty response or boolean by fetching list
-	if len(resp) == 0 || string(resp) == "true" {
-		return c.findSSHKeyByName(name)
+	var list []Registry
+	if err := json.Unmarshal(resp, &list); err == nil {
+		return list, nil
 	}
 
 	var wrapper struct {
-		SSHKey SSHKey `json:"sshKey"`
+		Registries []Registry `json:"registries"`
 	}
-	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.SSHKey.ID != "" {
-		return &wrapper.SSHKey, nil
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.Registries != nil {
+		return wrapper.Registries, nil
 	}
 
-	var result SSHKey
+	return nil, fmt.Errorf("failed to parse registry.all response")
+}
+
+func (c *DokployClient) GetRegistry(ctx context.Context, id string) (*Registry, error) {
+	endpoint := fmt.Sprintf("registry.one?registryId=%s", id)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Registry
 	if err := json.Unmarshal(resp, &result); err != nil {
 		return nil, err
 	}
-	if result.ID == "" {
-		return c.findSSHKeyByName(name)
+	return &result, nil
+}
+
+func (c *DokployClient) UpdateRegistry(ctx context.Context, registry Registry) (*Registry, error) {
+	payload := registryPayload(registry)
+	payload["registryId"] = registry.ID
+
+	if _, err := c.doRequest(ctx, "POST", "registry.update", payload); err != nil {
+		return nil, err
 	}
+	return c.GetRegistry(ctx, registry.ID)
+}
 
-	// Fallback to list lookup if unmarshal failed to produce ID
-	return &result, nil
+func (c *DokployClient) DeleteRegistry(ctx context.Context, id string) error {
+	payload := map[string]string{
+		"registryId": id,
+	}
+	_, err := c.doRequest(ctx, "POST", "registry.remove", payload)
+	return err
 }
 
-func (c *DokployClient) ListSSHKeys() ([]SSHKey, error) {
-	resp, err := c.doRequest("GET", "sshKey.all", nil)
+// TestRegistry asks Dokploy to log in to the registry with the given
+// credentials, from the registry's server when one is set.
+func (c *DokployClient) TestRegistry(ctx context.Context, registry Registry) error {
+	resp, err := c.doRequest(ctx, "POST", "registry.testRegistry", registryPayload(registry))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	var wrapper struct {
-		SSHKeys []SSHKey `json:"sshKeys"` // Guessing wrapper
+	var ok bool
+	if err := json.Unmarshal(resp, &ok); err == nil && !ok {
+		return fmt.Errorf("dokploy could not log in to registry %s", registry.RegistryURL)
 	}
-	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.SSHKeys != nil {
-		return wrapper.SSHKeys, nil
+	return nil
+}
+
+// --- Database Backup ---
+
+// DatabaseBackup is a scheduled dump of a postgres, mysql, mariadb or mongo
+// database to a backup destination.
+type DatabaseBackup struct {
+	ID              string `json:"backupId"`
+	DatabaseType    string `json:"databaseType"`
+	Database        string `json:"database"`
+	Schedule        string `json:"schedule"`
+	Prefix          string `json:"prefix"`
+	DestinationID   string `json:"destinationId"`
+	KeepLatestCount int64  `json:"keepLatestCount"`
+	Enabled         bool   `json:"enabled"`
+	BackupType      string `json:"backupType"`
+	PostgresID      string `json:"postgresId"`
+	MysqlID         string `json:"mysqlId"`
+	MariadbID       string `json:"mariadbId"`
+	MongoID         string `json:"mongoId"`
+}
+
+// DatabaseID returns the engine-specific ID of the backed up database.
+func (b DatabaseBackup) DatabaseID() string {
+	switch b.DatabaseType {
+	case "postgres":
+		return b.PostgresID
+	case "mysql":
+		return b.MysqlID
+	case "mariadb":
+		return b.MariadbID
+	case "mongo":
+		return b.MongoID
 	}
+	return ""
+}
 
-	var list []SSHKey
-	if err := json.Unmarshal(resp, &list); err == nil {
-		return list, nil
+// databaseBackupIDKey returns the payload key that links a backup to a
+// database of the given engine type.
+func databaseBackupIDKey(databaseType string) (string, error) {
+	switch databaseType {
+	case "postgres":
+		return "postgresId", nil
+	case "mysql":
+		return "mysqlId", nil
+	case "mariadb":
+		return "mariadbId", nil
+	case "mongo":
+		return "mongoId", nil
+	default:
+		return "", fmt.Errorf("unsupported database type for backups: %s", databaseType)
 	}
+}
 
-	return nil, fmt.Errorf("failed to parse sshKey.all response")
+func databaseBackupPayload(backup DatabaseBackup) map[string]interface{} {
+	return map[string]interface{}{
+		"databaseType":    backup.DatabaseType,
+		"database":        backup.Database,
+		"schedule":        backup.Schedule,
+		"prefix":          backup.Prefix,
+		"destinationId":   backup.DestinationID,
+		"keepLatestCount": backup.KeepLatestCount,
+		"enabled":         backup.Enabled,
+	}
 }
 
-func (c *DokployClient) findSSHKeyByName(name string) (*SSHKey, error) {
-	keys, err := c.ListSSHKeys()
+// CreateDatabaseBackup schedules a backup for the database identified by
+// databaseID, which is the engine-specific ID (postgresId, mysqlId, ...).
+func (c *DokployClient) CreateDatabaseBackup(ctx context.Context, databaseID string, backup DatabaseBackup) (*DatabaseBackup, error) {
+	idKey, err := databaseBackupIDKey(backup.DatabaseType)
 	if err != nil {
-		return nil, fmt.Errorf("ssh key created but failed to list keys: %w", err)
+		return nil, err
 	}
-	for _, key := range keys {
-		if key.Name == name {
-			return &key, nil
+
+	payload := databaseBackupPayload(backup)
+	payload["backupType"] = "database"
+	payload[idKey] = databaseID
+
+	resp, err := c.doRequest(ctx, "POST", "backup.create", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	created, parseErr := parseDatabaseBackupResponse(resp)
+	if parseErr == nil {
+		return created, nil
+	}
+
+	// Older Dokploy versions return no body; find the new schedule on the database.
+	db, err := c.GetDatabase(ctx, databaseID, backup.DatabaseType)
+	if err != nil {
+		return nil, fmt.Errorf("backup created but response was not parseable (%v) and database lookup failed: %w", parseErr, err)
+	}
+	for i := len(db.Backups) - 1; i >= 0; i-- {
+		candidate := db.Backups[i]
+		if candidate.DestinationID == backup.DestinationID && candidate.Prefix == backup.Prefix &&
+			candidate.Schedule == backup.Schedule && candidate.Database == backup.Database {
+			if candidate.DatabaseType == "" {
+				candidate.DatabaseType = backup.DatabaseType
+			}
+			return &candidate, nil
 		}
 	}
-	return nil, fmt.Errorf("ssh key created but not found in list by name: %s", name)
+	return nil, fmt.Errorf("backup created but not found on %s database %s", backup.DatabaseType, databaseID)
 }
 
-func (c *DokployClient) GetSSHKey(id string) (*SSHKey, error) {
-	endpoint := fmt.Sprintf("sshKey.one?sshKeyId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+func (c *DokployClient) GetDatabaseBackup(ctx context.Context, id string) (*DatabaseBackup, error) {
+	endpoint := fmt.Sprintf("backup.one?backupId=%s", id)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
-	var result SSHKey
-	if err := json.Unmarshal(resp, &result); err != nil {
-		// Try wrapper?
-		var wrapper struct {
-			SSHKey SSHKey `json:"sshKey"`
-		}
-		if err2 := json.Unmarshal(resp, &wrapper); err2 == nil {
-			return &wrapper.SSHKey, nil
-		}
+	return parseDatabaseBackupResponse(resp)
+}
+
+func (c *DokployClient) UpdateDatabaseBackup(ctx context.Context, backup DatabaseBackup) (*DatabaseBackup, error) {
+	payload := databaseBackupPayload(backup)
+	payload["backupId"] = backup.ID
+
+	if _, err := c.doRequest(ctx, "POST", "backup.update", payload); err != nil {
 		return nil, err
 	}
-	return &result, nil
+	return c.GetDatabaseBackup(ctx, backup.ID)
 }
 
-func (c *DokployClient) DeleteSSHKey(id string) error {
+func (c *DokployClient) DeleteDatabaseBackup(ctx context.Context, id string) error {
 	payload := map[string]string{
-		"sshKeyId": id,
+		"backupId": id,
 	}
-	_, err := c.doRequest("POST", "sshKey.remove", payload)
+	_, err := c.doRequest(ctx, "POST", "backup.remove", payload)
 	return err
 }
 
+func parseDatabaseBackupResponse(resp []byte) (*DatabaseBackup, error) {
+	var direct DatabaseBackup
+	if err := json.Unmarshal(resp, &direct); err == nil && direct.ID != "" {
+		return &direct, nil
+	}
+
+	var wrapper struct {
+		Backup DatabaseBackup `json:"backup"`
+	}
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.Backup.ID != "" {
+		return &wrapper.Backup, nil
+	}
+
+	return nil, fmt.Errorf("failed to parse backup response")
+}
+
 // --- Volume Backup ---
 
 type VolumeBackup struct {
 	ID              string `json:"volumeBackupId"`
 	Name            string `json:"name"`
 	ServiceType     string `json:"serviceType"`
+	ApplicationID   string `json:"applicationId"`
 	ComposeID       string `json:"composeId"`
 	AppName         string `json:"appName"`
 	ServiceName     string `json:"serviceName"`
@@ -2190,12 +4189,31 @@ type BackupDestination struct {
 	SecretAccessKey string `json:"secretAccessKey"`
 }
 
-func (c *DokployClient) CreateVolumeBackup(backup VolumeBackup) (*VolumeBackup, error) {
+// volumeBackupServiceType returns the service type of the backup target,
+// inferring it from the target ID when it is not set.
+func volumeBackupServiceType(backup VolumeBackup) string {
+	if backup.ServiceType != "" {
+		return backup.ServiceType
+	}
+	if backup.ApplicationID != "" {
+		return "application"
+	}
+	return "compose"
+}
+
+// volumeBackupTargetID returns the application or compose ID the backup is
+// attached to.
+func volumeBackupTargetID(backup VolumeBackup) string {
+	if volumeBackupServiceType(backup) == "application" {
+		return backup.ApplicationID
+	}
+	return backup.ComposeID
+}
+
+func volumeBackupPayload(backup VolumeBackup) map[string]interface{} {
 	payload := map[string]interface{}{
 		"name":            backup.Name,
-		"serviceType":     backup.ServiceType,
-		"composeId":       backup.ComposeID,
-		"serviceName":     backup.ServiceName,
+		"serviceType":     volumeBackupServiceType(backup),
 		"volumeName":      backup.VolumeName,
 		"destinationId":   backup.DestinationID,
 		"cronExpression":  backup.CronExpression,
@@ -2203,8 +4221,11 @@ func (c *DokployClient) CreateVolumeBackup(backup VolumeBackup) (*VolumeBackup,
 		"enabled":         backup.Enabled,
 		"keepLatestCount": backup.KeepLatestCount,
 	}
-	if payload["serviceType"] == "" {
-		payload["serviceType"] = "compose"
+	if payload["serviceType"] == "application" {
+		payload["applicationId"] = backup.ApplicationID
+	} else {
+		payload["composeId"] = backup.ComposeID
+		payload["serviceName"] = backup.ServiceName
 	}
 	if backup.Prefix != "" {
 		payload["prefix"] = backup.Prefix
@@ -2212,8 +4233,11 @@ func (c *DokployClient) CreateVolumeBackup(backup VolumeBackup) (*VolumeBackup,
 	if backup.AppName != "" {
 		payload["appName"] = backup.AppName
 	}
+	return payload
+}
 
-	resp, err := c.doRequest("POST", "volumeBackups.create", payload)
+func (c *DokployClient) CreateVolumeBackup(ctx context.Context, backup VolumeBackup) (*VolumeBackup, error) {
+	resp, err := c.doRequest(ctx, "POST", "volumeBackups.create", volumeBackupPayload(backup))
 	if err != nil {
 		return nil, err
 	}
@@ -2223,47 +4247,27 @@ func (c *DokployClient) CreateVolumeBackup(backup VolumeBackup) (*VolumeBackup,
 		return created, nil
 	}
 
-	found, findErr := c.findVolumeBackupByTarget(backup.ComposeID, backup.Name, backup.ServiceName, backup.VolumeName)
+	found, findErr := c.findVolumeBackupByTarget(ctx, backup)
 	if findErr != nil {
 		return nil, fmt.Errorf("volume backup created but response was not parseable (%v) and lookup failed: %w", parseErr, findErr)
 	}
 	return found, nil
 }
 
-func (c *DokployClient) GetVolumeBackup(id string) (*VolumeBackup, error) {
+func (c *DokployClient) GetVolumeBackup(ctx context.Context, id string) (*VolumeBackup, error) {
 	endpoint := fmt.Sprintf("volumeBackups.one?volumeBackupId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 	return parseVolumeBackupResponse(resp)
 }
 
-func (c *DokployClient) UpdateVolumeBackup(backup VolumeBackup) (*VolumeBackup, error) {
-	payload := map[string]interface{}{
-		"volumeBackupId":  backup.ID,
-		"name":            backup.Name,
-		"serviceType":     backup.ServiceType,
-		"composeId":       backup.ComposeID,
-		"serviceName":     backup.ServiceName,
-		"volumeName":      backup.VolumeName,
-		"destinationId":   backup.DestinationID,
-		"cronExpression":  backup.CronExpression,
-		"turnOff":         backup.TurnOff,
-		"enabled":         backup.Enabled,
-		"keepLatestCount": backup.KeepLatestCount,
-	}
-	if payload["serviceType"] == "" {
-		payload["serviceType"] = "compose"
-	}
-	if backup.Prefix != "" {
-		payload["prefix"] = backup.Prefix
-	}
-	if backup.AppName != "" {
-		payload["appName"] = backup.AppName
-	}
+func (c *DokployClient) UpdateVolumeBackup(ctx context.Context, backup VolumeBackup) (*VolumeBackup, error) {
+	payload := volumeBackupPayload(backup)
+	payload["volumeBackupId"] = backup.ID
 
-	resp, err := c.doRequest("POST", "volumeBackups.update", payload)
+	resp, err := c.doRequest(ctx, "POST", "volumeBackups.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -2272,32 +4276,34 @@ func (c *DokployClient) UpdateVolumeBackup(backup VolumeBackup) (*VolumeBackup,
 	if parseErr == nil && updated.ID != "" {
 		return updated, nil
 	}
-	return c.GetVolumeBackup(backup.ID)
+	return c.GetVolumeBackup(ctx, backup.ID)
 }
 
-func (c *DokployClient) DeleteVolumeBackup(id string) error {
+func (c *DokployClient) DeleteVolumeBackup(ctx context.Context, id string) error {
 	payload := map[string]string{
 		"volumeBackupId": id,
 	}
-	_, err := c.doRequest("POST", "volumeBackups.delete", payload)
+	_, err := c.doRequest(ctx, "POST", "volumeBackups.delete", payload)
 	if err == nil {
 		return nil
 	}
 
 	// Backward compatibility with older Dokploy versions.
-	_, removeErr := c.doRequest("POST", "volumeBackups.remove", payload)
+	_, removeErr := c.doRequest(ctx, "POST", "volumeBackups.remove", payload)
 	if removeErr != nil {
 		return fmt.Errorf("volumeBackups.delete failed: %w; volumeBackups.remove fallback failed: %w", err, removeErr)
 	}
 	return nil
 }
 
-func (c *DokployClient) ListVolumeBackups(composeID string) ([]VolumeBackup, error) {
-	endpoint := fmt.Sprintf("volumeBackups.list?id=%s&volumeBackupType=compose", composeID)
-	resp, err := c.doRequest("GET", endpoint, nil)
+// ListVolumeBackups lists the volume backups of an application or compose
+// stack; serviceType is "application" or "compose".
+func (c *DokployClient) ListVolumeBackups(ctx context.Context, serviceType, id string) ([]VolumeBackup, error) {
+	endpoint := fmt.Sprintf("volumeBackups.list?id=%s&volumeBackupType=%s", id, serviceType)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
-		legacyEndpoint := fmt.Sprintf("volumeBackups.all?id=%s&type=compose", composeID)
-		legacyResp, legacyErr := c.doRequest("GET", legacyEndpoint, nil)
+		legacyEndpoint := fmt.Sprintf("volumeBackups.all?id=%s&type=%s", id, serviceType)
+		legacyResp, legacyErr := c.doRequest(ctx, "GET", legacyEndpoint, nil)
 		if legacyErr != nil {
 			return nil, fmt.Errorf("volumeBackups.list failed: %w; volumeBackups.all fallback failed: %w", err, legacyErr)
 		}
@@ -2306,8 +4312,8 @@ func (c *DokployClient) ListVolumeBackups(composeID string) ([]VolumeBackup, err
 	return parseVolumeBackupListResponse(resp)
 }
 
-func (c *DokployClient) ListBackupDestinations() ([]BackupDestination, error) {
-	resp, err := c.doRequest("GET", "destination.all", nil)
+func (c *DokployClient) ListBackupDestinations(ctx context.Context) ([]BackupDestination, error) {
+	resp, err := c.doRequest(ctx, "GET", "destination.all", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2327,7 +4333,7 @@ func (c *DokployClient) ListBackupDestinations() ([]BackupDestination, error) {
 	return nil, fmt.Errorf("failed to parse destination.all response")
 }
 
-func (c *DokployClient) CreateBackupDestination(destination BackupDestination) (*BackupDestination, error) {
+func (c *DokployClient) CreateBackupDestination(ctx context.Context, destination BackupDestination) (*BackupDestination, error) {
 	provider := destination.Provider
 	if provider == "" {
 		provider = destination.Type
@@ -2358,7 +4364,7 @@ func (c *DokployClient) CreateBackupDestination(destination BackupDestination) (
 	// destination.create requires region and endpoint; allow empty values to pass
 	// through so Dokploy can return explicit validation diagnostics.
 
-	resp, err := c.doRequest("POST", "destination.create", payload)
+	resp, err := c.doRequest(ctx, "POST", "destination.create", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -2368,23 +4374,23 @@ func (c *DokployClient) CreateBackupDestination(destination BackupDestination) (
 		return created, nil
 	}
 
-	found, findErr := c.FindBackupDestinationByName(destination.Name)
+	found, findErr := c.FindBackupDestinationByName(ctx, destination.Name)
 	if findErr != nil {
 		return nil, fmt.Errorf("destination created but response was not parseable (%v) and lookup failed: %w", parseErr, findErr)
 	}
 	return found, nil
 }
 
-func (c *DokployClient) GetBackupDestination(id string) (*BackupDestination, error) {
+func (c *DokployClient) GetBackupDestination(ctx context.Context, id string) (*BackupDestination, error) {
 	endpoint := fmt.Sprintf("destination.one?destinationId=%s", id)
-	resp, err := c.doRequest("GET", endpoint, nil)
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 	return parseBackupDestinationResponse(resp)
 }
 
-func (c *DokployClient) UpdateBackupDestination(destination BackupDestination) (*BackupDestination, error) {
+func (c *DokployClient) UpdateBackupDestination(ctx context.Context, destination BackupDestination) (*BackupDestination, error) {
 	provider := destination.Provider
 	if provider == "" {
 		provider = destination.Type
@@ -2416,7 +4422,7 @@ func (c *DokployClient) UpdateBackupDestination(destination BackupDestination) (
 	// destination.update requires region and endpoint; allow empty values to pass
 	// through so Dokploy can return explicit validation diagnostics.
 
-	resp, err := c.doRequest("POST", "destination.update", payload)
+	resp, err := c.doRequest(ctx, "POST", "destination.update", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -2425,19 +4431,19 @@ func (c *DokployClient) UpdateBackupDestination(destination BackupDestination) (
 	if parseErr == nil && updated.ID != "" {
 		return updated, nil
 	}
-	return c.GetBackupDestination(destination.ID)
+	return c.GetBackupDestination(ctx, destination.ID)
 }
 
-func (c *DokployClient) DeleteBackupDestination(id string) error {
+func (c *DokployClient) DeleteBackupDestination(ctx context.Context, id string) error {
 	payload := map[string]string{
 		"destinationId": id,
 	}
-	_, err := c.doRequest("POST", "destination.remove", payload)
+	_, err := c.doRequest(ctx, "POST", "destination.remove", payload)
 	return err
 }
 
-func (c *DokployClient) FindBackupDestinationByName(name string) (*BackupDestination, error) {
-	destinations, err := c.ListBackupDestinations()
+func (c *DokployClient) FindBackupDestinationByName(ctx context.Context, name string) (*BackupDestination, error) {
+	destinations, err := c.ListBackupDestinations(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2468,19 +4474,19 @@ func parseBackupDestinationResponse(resp []byte) (*BackupDestination, error) {
 	return nil, fmt.Errorf("failed to parse destination response")
 }
 
-func (c *DokployClient) findVolumeBackupByTarget(composeID, name, serviceName, volumeName string) (*VolumeBackup, error) {
-	backups, err := c.ListVolumeBackups(composeID)
+func (c *DokployClient) findVolumeBackupByTarget(ctx context.Context, target VolumeBackup) (*VolumeBackup, error) {
+	backups, err := c.ListVolumeBackups(ctx, volumeBackupServiceType(target), volumeBackupTargetID(target))
 	if err != nil {
 		return nil, err
 	}
 
 	for _, backup := range backups {
-		if backup.Name == name && backup.ServiceName == serviceName && backup.VolumeName == volumeName {
+		if backup.Name == target.Name && backup.ServiceName == target.ServiceName && backup.VolumeName == target.VolumeName {
 			return &backup, nil
 		}
 	}
 
-	return nil, fmt.Errorf("volume backup not found by target (name=%s, service=%s, volume=%s)", name, serviceName, volumeName)
+	return nil, fmt.Errorf("volume backup not found by target (name=%s, service=%s, volume=%s)", target.Name, target.ServiceName, target.VolumeName)
 }
 
 func parseVolumeBackupResponse(resp []byte) (*VolumeBackup, error) {
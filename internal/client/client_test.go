@@ -1,12 +1,20 @@
 package client
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"golang.org/x/net/websocket"
 )
 
 func boolPointer(v bool) *bool {
@@ -29,7 +37,7 @@ func TestReadTraefikConfig_ParsesRawStringResponse(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	got, err := c.ReadTraefikConfig(nil)
+	got, err := c.ReadTraefikConfig(context.Background(), nil)
 	if err != nil {
 		t.Fatalf("ReadTraefikConfig returned error: %v", err)
 	}
@@ -50,7 +58,7 @@ func TestReadTraefikConfig_ParsesWrappedResponse(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	got, err := c.ReadTraefikConfig(nil)
+	got, err := c.ReadTraefikConfig(context.Background(), nil)
 	if err != nil {
 		t.Fatalf("ReadTraefikConfig returned error: %v", err)
 	}
@@ -74,7 +82,7 @@ func TestReadTraefikConfig_IncludesServerIDInQuery(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	if _, err := c.ReadTraefikConfig(&serverID); err != nil {
+	if _, err := c.ReadTraefikConfig(context.Background(), &serverID); err != nil {
 		t.Fatalf("ReadTraefikConfig returned error: %v", err)
 	}
 }
@@ -109,7 +117,7 @@ func TestUpdateTraefikConfig_SendsExpectedPayload(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	if err := c.UpdateTraefikConfig(&serverID, expectedConfig); err != nil {
+	if err := c.UpdateTraefikConfig(context.Background(), &serverID, expectedConfig); err != nil {
 		t.Fatalf("UpdateTraefikConfig returned error: %v", err)
 	}
 }
@@ -125,7 +133,7 @@ func TestReadWebServerTraefikConfig_UsesScopedEndpointAndKey(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	got, err := c.ReadWebServerTraefikConfig(nil)
+	got, err := c.ReadWebServerTraefikConfig(context.Background(), nil)
 	if err != nil {
 		t.Fatalf("ReadWebServerTraefikConfig returned error: %v", err)
 	}
@@ -157,7 +165,7 @@ func TestUpdateWebServerTraefikConfig_UsesScopedEndpointAndPayloadKey(t *testing
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	if err := c.UpdateWebServerTraefikConfig(nil, expectedConfig); err != nil {
+	if err := c.UpdateWebServerTraefikConfig(context.Background(), nil, expectedConfig); err != nil {
 		t.Fatalf("UpdateWebServerTraefikConfig returned error: %v", err)
 	}
 }
@@ -189,7 +197,7 @@ func TestUpdateMiddlewareTraefikConfig_UsesScopedEndpointAndPayloadKey(t *testin
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	if err := c.UpdateMiddlewareTraefikConfig(&serverID, expectedConfig); err != nil {
+	if err := c.UpdateMiddlewareTraefikConfig(context.Background(), &serverID, expectedConfig); err != nil {
 		t.Fatalf("UpdateMiddlewareTraefikConfig returned error: %v", err)
 	}
 }
@@ -220,7 +228,7 @@ func TestReloadTraefik_SendsExpectedPayload(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	if err := c.ReloadTraefik(&serverID); err != nil {
+	if err := c.ReloadTraefik(context.Background(), &serverID); err != nil {
 		t.Fatalf("ReloadTraefik returned error: %v", err)
 	}
 }
@@ -241,7 +249,7 @@ func TestDeleteApplication_UsesDeleteEndpoint(t *testing.T) {
 
 	c := NewDokployClient(server.URL, "test-key")
 
-	if err := c.DeleteApplication("app-123"); err != nil {
+	if err := c.DeleteApplication(context.Background(), "app-123"); err != nil {
 		t.Fatalf("DeleteApplication returned error: %v", err)
 	}
 
@@ -273,7 +281,7 @@ func TestDeleteApplication_FallsBackToRemove(t *testing.T) {
 
 	c := NewDokployClient(server.URL, "test-key")
 
-	if err := c.DeleteApplication("app-123"); err != nil {
+	if err := c.DeleteApplication(context.Background(), "app-123"); err != nil {
 		t.Fatalf("DeleteApplication returned error: %v", err)
 	}
 
@@ -303,7 +311,7 @@ func TestDeleteApplication_ReturnsErrorWhenDeleteAndRemoveFail(t *testing.T) {
 
 	c := NewDokployClient(server.URL, "test-key")
 
-	err := c.DeleteApplication("app-123")
+	err := c.DeleteApplication(context.Background(), "app-123")
 	if err == nil {
 		t.Fatal("expected an error, got nil")
 	}
@@ -336,7 +344,7 @@ func TestCreateApplication_IncludesPreviewPayload(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	_, err := c.CreateApplication(Application{
+	_, err := c.CreateApplication(context.Background(), Application{
 		Name:                                  "rssmate",
 		EnvironmentID:                         "env-123",
 		Branch:                                "main",
@@ -423,7 +431,7 @@ func TestUpdateApplication_SendsExplicitFalseAndZeroPreviewValues(t *testing.T)
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	_, err := c.UpdateApplication(Application{
+	_, err := c.UpdateApplication(context.Background(), Application{
 		ID:                                    "app-123",
 		Name:                                  "rssmate",
 		Branch:                                "main",
@@ -501,7 +509,7 @@ func TestCreateMount_UsesMountsCreateEndpointAndPayload(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	mount, err := c.CreateMount(Mount{
+	mount, err := c.CreateMount(context.Background(), Mount{
 		ApplicationID: "app-123",
 		MountType:     "volume",
 		MountPath:     "/data",
@@ -531,7 +539,7 @@ func TestCreateMount_FallbackLookupOnBooleanResponse(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	mount, err := c.CreateMount(Mount{
+	mount, err := c.CreateMount(context.Background(), Mount{
 		ApplicationID: "app-123",
 		MountType:     "volume",
 		MountPath:     "/data",
@@ -561,7 +569,7 @@ func TestCreateMount_BooleanResponseWithEmptyLookupDoesNotFail(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	mount, err := c.CreateMount(Mount{
+	mount, err := c.CreateMount(context.Background(), Mount{
 		ApplicationID: "app-123",
 		MountType:     "volume",
 		MountPath:     "/data",
@@ -596,7 +604,7 @@ func TestDeleteMount_UsesRemoveEndpoint(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	if err := c.DeleteMount("mount-123"); err != nil {
+	if err := c.DeleteMount(context.Background(), "mount-123"); err != nil {
 		t.Fatalf("DeleteMount returned error: %v", err)
 	}
 
@@ -624,7 +632,7 @@ func TestDeleteMount_FallsBackToLegacyEndpoint(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	if err := c.DeleteMount("mount-123"); err != nil {
+	if err := c.DeleteMount(context.Background(), "mount-123"); err != nil {
 		t.Fatalf("DeleteMount returned error: %v", err)
 	}
 
@@ -677,7 +685,7 @@ func TestUpdateProjectEnv_UpdatesProjectEnvironment(t *testing.T) {
 
 	c := NewDokployClient(server.URL, "test-key")
 
-	err := c.UpdateProjectEnv("proj-1", func(envMap map[string]string) {
+	err := c.UpdateProjectEnv(context.Background(), "proj-1", func(envMap map[string]string) {
 		envMap["C"] = "3"
 	})
 	if err != nil {
@@ -714,7 +722,7 @@ func TestUpdateProjectEnv_NoChangesSkipsUpdate(t *testing.T) {
 
 	c := NewDokployClient(server.URL, "test-key")
 
-	err := c.UpdateProjectEnv("proj-1", func(envMap map[string]string) {
+	err := c.UpdateProjectEnv(context.Background(), "proj-1", func(envMap map[string]string) {
 		envMap["A"] = "1"
 	})
 	if err != nil {
@@ -726,6 +734,63 @@ func TestUpdateProjectEnv_NoChangesSkipsUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdateApplicationEnv_DoesNotRetryFailedWrite(t *testing.T) {
+	writes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.one":
+			_, _ = w.Write([]byte(`{"applicationId":"app-1","env":"A=1"}`))
+		case "/application.saveEnvironment":
+			writes++
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL)
+	c.MaxRetries = 2
+	err := c.UpdateApplicationEnv(context.Background(), "app-1", func(envMap map[string]string) {
+		envMap["B"] = "2"
+	}, nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if writes != 3 {
+		t.Fatalf("expected only the request retries (3 writes), got %d", writes)
+	}
+}
+
+func TestUpdateComposeEnv_RetriesOnConcurrentChange(t *testing.T) {
+	writes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/compose.one":
+			// Another writer keeps overwriting the env.
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"composeId":"cmp-1","env":"A=%d"}`, writes)))
+		case "/compose.update":
+			writes++
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL)
+	err := c.UpdateComposeEnv(context.Background(), "cmp-1", func(envMap map[string]string) {
+		envMap["B"] = "2"
+	}, nil)
+	if err == nil || !strings.Contains(err.Error(), "conflict") {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+	if writes != envUpdateAttempts {
+		t.Fatalf("expected %d writes, got %d", envUpdateAttempts, writes)
+	}
+}
+
 func TestCreateDatabase_MySQLDirectResponseUsesMysqlIDAsID(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
@@ -740,7 +805,7 @@ func TestCreateDatabase_MySQLDirectResponseUsesMysqlIDAsID(t *testing.T) {
 
 	c := NewDokployClient(server.URL, "test-key")
 
-	db, err := c.CreateDatabase("project-1", "env-1", "test-db", "mysql", "secret", "mysql:8")
+	db, err := c.CreateDatabase(context.Background(), Database{ProjectID: "project-1", EnvironmentID: "env-1", Name: "test-db", Type: "mysql", DatabasePassword: "secret", DockerImage: "mysql:8"})
 	if err != nil {
 		t.Fatalf("CreateDatabase returned error: %v", err)
 	}
@@ -766,7 +831,7 @@ func TestCreateDatabase_MySQLWrappedResponseUsesMysqlIDAsID(t *testing.T) {
 
 	c := NewDokployClient(server.URL, "test-key")
 
-	db, err := c.CreateDatabase("project-1", "env-1", "test-db", "mysql", "secret", "mysql:8")
+	db, err := c.CreateDatabase(context.Background(), Database{ProjectID: "project-1", EnvironmentID: "env-1", Name: "test-db", Type: "mysql", DatabasePassword: "secret", DockerImage: "mysql:8"})
 	if err != nil {
 		t.Fatalf("CreateDatabase returned error: %v", err)
 	}
@@ -810,7 +875,7 @@ func TestCreateVolumeBackup_UsesComposeEndpointAndPayload(t *testing.T) {
 
 	c := NewDokployClient(server.URL, "test-key")
 
-	backup, err := c.CreateVolumeBackup(VolumeBackup{
+	backup, err := c.CreateVolumeBackup(context.Background(), VolumeBackup{
 		Name:            "ghost-content",
 		ComposeID:       "compose-123",
 		AppName:         "fca-ghost-kqlble",
@@ -850,7 +915,7 @@ func TestCreateVolumeBackup_FallbackLookupOnBooleanResponse(t *testing.T) {
 
 	c := NewDokployClient(server.URL, "test-key")
 
-	backup, err := c.CreateVolumeBackup(VolumeBackup{
+	backup, err := c.CreateVolumeBackup(context.Background(), VolumeBackup{
 		Name:          "ghost-content",
 		ComposeID:     "compose-123",
 		ServiceName:   "ghost",
@@ -865,6 +930,48 @@ func TestCreateVolumeBackup_FallbackLookupOnBooleanResponse(t *testing.T) {
 	}
 }
 
+func TestCreateVolumeBackup_ApplicationTargetFallsBackToApplicationList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/volumeBackups.create":
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			if payload["serviceType"] != "application" || payload["applicationId"] != "app-123" {
+				t.Fatalf("unexpected target: %#v", payload)
+			}
+			if _, ok := payload["composeId"]; ok {
+				t.Fatalf("composeId should not be sent for applications: %#v", payload)
+			}
+			_, _ = w.Write([]byte(`true`))
+		case "/volumeBackups.list":
+			if got := r.URL.Query().Get("volumeBackupType"); got != "application" {
+				t.Fatalf("unexpected volumeBackupType: %q", got)
+			}
+			_, _ = w.Write([]byte(`[{"volumeBackupId":"vb-app","name":"uploads","applicationId":"app-123","volumeName":"uploads-data"}]`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+
+	backup, err := c.CreateVolumeBackup(context.Background(), VolumeBackup{
+		Name:          "uploads",
+		ApplicationID: "app-123",
+		VolumeName:    "uploads-data",
+		DestinationID: "dest-123",
+	})
+	if err != nil {
+		t.Fatalf("CreateVolumeBackup returned error: %v", err)
+	}
+	if backup.ID != "vb-app" {
+		t.Fatalf("unexpected backup ID: got %q want %q", backup.ID, "vb-app")
+	}
+}
+
 func TestDeleteVolumeBackup_UsesDeleteEndpoint(t *testing.T) {
 	var calls []string
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -880,7 +987,7 @@ func TestDeleteVolumeBackup_UsesDeleteEndpoint(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	if err := c.DeleteVolumeBackup("vb-123"); err != nil {
+	if err := c.DeleteVolumeBackup(context.Background(), "vb-123"); err != nil {
 		t.Fatalf("DeleteVolumeBackup returned error: %v", err)
 	}
 
@@ -904,7 +1011,7 @@ func TestFindBackupDestinationByName(t *testing.T) {
 
 	c := NewDokployClient(server.URL, "test-key")
 
-	destination, err := c.FindBackupDestinationByName("hetzner backup s3 bucket")
+	destination, err := c.FindBackupDestinationByName(context.Background(), "hetzner backup s3 bucket")
 	if err != nil {
 		t.Fatalf("FindBackupDestinationByName returned error: %v", err)
 	}
@@ -954,7 +1061,7 @@ func TestCreateBackupDestination_UsesDestinationCreateEndpoint(t *testing.T) {
 
 	c := NewDokployClient(server.URL, "test-key")
 
-	destination, err := c.CreateBackupDestination(BackupDestination{
+	destination, err := c.CreateBackupDestination(context.Background(), BackupDestination{
 		Name:            "Hetzner backup s3 bucket",
 		Type:            "s3",
 		Bucket:          "backups-2f6fe75d",
@@ -1007,7 +1114,7 @@ func TestUpdateBackupDestination_FallsBackToGetDestination(t *testing.T) {
 
 	c := NewDokployClient(server.URL, "test-key")
 
-	destination, err := c.UpdateBackupDestination(BackupDestination{
+	destination, err := c.UpdateBackupDestination(context.Background(), BackupDestination{
 		ID:              "dest-123",
 		Name:            "Hetzner backup s3 bucket",
 		Type:            "s3",
@@ -1024,3 +1131,1347 @@ func TestUpdateBackupDestination_FallsBackToGetDestination(t *testing.T) {
 		t.Fatalf("unexpected destination ID: got %q want %q", destination.ID, "dest-123")
 	}
 }
+
+func TestListProjects_ParsesDirectAndWrappedResponses(t *testing.T) {
+	for _, body := range []string{
+		`[{"projectId":"proj-1","name":"shop"}]`,
+		`{"projects":[{"projectId":"proj-1","name":"shop"}]}`,
+	} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/project.all" {
+				t.Fatalf("unexpected endpoint: %s", r.URL.Path)
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(body))
+		}))
+
+		c := NewDokployClient(server.URL, "test-key")
+		projects, err := c.ListProjects(context.Background())
+		server.Close()
+		if err != nil {
+			t.Fatalf("ListProjects returned error for %s: %v", body, err)
+		}
+		if len(projects) != 1 || projects[0].ID != "proj-1" || projects[0].Name != "shop" {
+			t.Fatalf("unexpected projects for %s: %+v", body, projects)
+		}
+	}
+}
+
+func TestFindDatabaseByName_UsesTypeSpecificID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/project.one" {
+			t.Fatalf("unexpected endpoint: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("projectId"); got != "proj-1" {
+			t.Fatalf("unexpected projectId: %s", got)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"projectId":"proj-1","environments":[
+			{"environmentId":"env-other","postgres":[{"postgresId":"pg-other","name":"main"}]},
+			{"environmentId":"env-1","postgres":[{"postgresId":"pg-1","name":"main","appName":"main-abc123"}]}
+		]}`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	db, err := c.FindDatabaseByName(context.Background(), "proj-1", "env-1", "postgres", "main-abc123")
+	if err != nil {
+		t.Fatalf("FindDatabaseByName returned error: %v", err)
+	}
+	if db.ID != "pg-1" {
+		t.Fatalf("unexpected database id: %s", db.ID)
+	}
+	if db.Type != "postgres" {
+		t.Fatalf("unexpected database type: %s", db.Type)
+	}
+
+	if _, err := c.FindDatabaseByName(context.Background(), "proj-1", "env-1", "mysql", "main"); err == nil {
+		t.Fatal("expected error for database of another type")
+	}
+}
+
+func newRetryTestClient(url string) *DokployClient {
+	c := NewDokployClient(url, "test-key")
+	c.RetryMinWait = time.Millisecond
+	c.RetryMaxWait = 5 * time.Millisecond
+	return c
+}
+
+func TestDoRequest_RetriesGetOnBadGateway(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"projectId":"proj-1"}`))
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL)
+	project, err := c.GetProject(context.Background(), "proj-1")
+	if err != nil {
+		t.Fatalf("GetProject returned error: %v", err)
+	}
+	if project.ID != "proj-1" || attempts != 3 {
+		t.Fatalf("unexpected result: id=%q attempts=%d", project.ID, attempts)
+	}
+}
+
+func TestDoRequest_DoesNotRetryPostOnBadGateway(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL)
+	if _, err := c.CreateProject(context.Background(), "shop", ""); err == nil {
+		t.Fatal("expected error")
+	}
+	if attempts != 1 {
+		t.Fatalf("expected a single attempt, got %d", attempts)
+	}
+}
+
+func TestDoRequest_RetriesPostOnTooManyRequestsHonoringRetryAfter(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"project":{"projectId":"proj-1","name":"shop"}}`))
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL)
+	project, err := c.CreateProject(context.Background(), "shop", "")
+	if err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	if project.ID != "proj-1" || attempts != 2 {
+		t.Fatalf("unexpected result: id=%q attempts=%d", project.ID, attempts)
+	}
+}
+
+func TestDoRequest_StopsAfterMaxRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL)
+	c.MaxRetries = 2
+	if _, err := c.GetProject(context.Background(), "proj-1"); err == nil {
+		t.Fatal("expected error")
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	if got, ok := parseRetryAfter("7", now); !ok || got != 7*time.Second {
+		t.Fatalf("unexpected seconds value: %v %v", got, ok)
+	}
+	if got, ok := parseRetryAfter("Mon, 01 Jan 2024 12:00:30 GMT", now); !ok || got != 30*time.Second {
+		t.Fatalf("unexpected date value: %v %v", got, ok)
+	}
+	if _, ok := parseRetryAfter("soon", now); ok {
+		t.Fatal("expected invalid value to be rejected")
+	}
+}
+
+func TestBackoff_StaysWithinBounds(t *testing.T) {
+	c := &DokployClient{RetryMinWait: 100 * time.Millisecond, RetryMaxWait: time.Second}
+	for attempt := 0; attempt < 10; attempt++ {
+		got := c.backoff(attempt)
+		if got < 50*time.Millisecond || got > time.Second {
+			t.Fatalf("attempt %d: backoff %v out of bounds", attempt, got)
+		}
+	}
+}
+
+func TestDoRequest_ReturnsTypedAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Project not found","code":"NOT_FOUND","issues":[]}`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	_, err := c.GetProject(context.Background(), "proj-1")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Code != "NOT_FOUND" || apiErr.Message != "Project not found" {
+		t.Fatalf("unexpected api error: %+v", apiErr)
+	}
+	if apiErr.Endpoint != "project.one?projectId=proj-1" {
+		t.Fatalf("unexpected endpoint: %s", apiErr.Endpoint)
+	}
+	if !IsNotFound(err) || IsConflict(err) || IsUnauthorized(err) {
+		t.Fatalf("unexpected classification for %v", err)
+	}
+}
+
+func TestNewAPIError_ParsesTRPCEnvelope(t *testing.T) {
+	apiErr := newAPIError("POST", "project.create", http.StatusConflict, []byte(`{"error":{"json":{"message":"Project already exists","code":-32009,"data":{"code":"CONFLICT","httpStatus":409}}}}`))
+	if apiErr.Code != "CONFLICT" || apiErr.Message != "Project already exists" {
+		t.Fatalf("unexpected api error: %+v", apiErr)
+	}
+	if !IsConflict(apiErr) {
+		t.Fatal("expected conflict")
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	notFound := newAPIError("GET", "application.one?applicationId=a", http.StatusNotFound, []byte(`{"message":"Application not found","code":"NOT_FOUND"}`))
+	missingRoute := newAPIError("POST", "application.delete", http.StatusNotFound, []byte(`{"message":"No \"mutation\"-procedure on path \"application.delete\"","code":"NOT_FOUND"}`))
+	serverErr := newAPIError("GET", "project.one?projectId=p", http.StatusInternalServerError, []byte(`{"message":"upstream returned 404 Not Found"}`))
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "not found", err: notFound, want: true},
+		{name: "wrapped not found", err: fmt.Errorf("reading: %w", notFound), want: true},
+		{name: "server error mentioning 404", err: serverErr, want: false},
+		{name: "missing route falls back to not found", err: fmt.Errorf("delete failed: %w; remove fallback failed: %w", missingRoute, notFound), want: true},
+		{name: "fallback hit server error", err: fmt.Errorf("delete failed: %w; remove fallback failed: %w", notFound, serverErr), want: false},
+		{name: "missing route only", err: missingRoute, want: false},
+		{name: "plain error", err: errors.New("Not Found"), want: false},
+		{name: "nil", err: nil, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := IsNotFound(test.err); got != test.want {
+				t.Fatalf("IsNotFound(%v) = %v, want %v", test.err, got, test.want)
+			}
+		})
+	}
+}
+
+func TestIsUnauthorized(t *testing.T) {
+	err := newAPIError("GET", "user.get", http.StatusUnauthorized, []byte(`{"message":"Unauthorized","code":"UNAUTHORIZED"}`))
+	if !IsUnauthorized(fmt.Errorf("failed to get user: %w", err)) {
+		t.Fatal("expected unauthorized")
+	}
+}
+
+func TestDoRequest_ContextCancelsRetryWait(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	c.RetryMaxWait = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.GetProject(ctx, "proj-1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("retry wait ignored context cancellation (%v)", elapsed)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected a single attempt, got %d", attempts)
+	}
+}
+
+func TestMaskBody_MasksSecretsAndEnvValues(t *testing.T) {
+	body := []byte(`{"name":"db","databasePassword":"hunter2","destination":{"secretAccessKey":"s3cr3t","bucket":"backups"},"env":"# comment\nAPI_TOKEN=abc\nDEBUG=true","mounts":[{"privateKey":"-----BEGIN"}]}`)
+
+	got := maskBody(body)
+	for _, secret := range []string{"hunter2", "s3cr3t", "abc", "-----BEGIN"} {
+		if strings.Contains(got, secret) {
+			t.Fatalf("masked body still contains %q: %s", secret, got)
+		}
+	}
+	for _, kept := range []string{`"name":"db"`, `"bucket":"backups"`, `API_TOKEN=***`, `DEBUG=***`, `# comment`} {
+		if !strings.Contains(got, kept) {
+			t.Fatalf("masked body is missing %q: %s", kept, got)
+		}
+	}
+}
+
+func TestMaskBody_NonJSON(t *testing.T) {
+	if got := maskBody([]byte("entryPoints:\n  web: {}\n")); got != "<23 bytes, not JSON>" {
+		t.Fatalf("unexpected masked body: %s", got)
+	}
+}
+
+func TestDoRequest_LogsRequestWithoutSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"projectId":"proj-1","env":"SECRET=value"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	c := NewDokployClient(server.URL, "super-secret-key")
+	if _, err := c.UpdateProject(ctx, "proj-1", "shop", "super-secret-key in description"); err != nil {
+		t.Fatalf("UpdateProject returned error: %v", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log output: %v", err)
+	}
+
+	var sawRequest bool
+	for _, entry := range entries {
+		if entry["@message"] == "Dokploy API request" {
+			sawRequest = true
+			if entry["method"] != "POST" || entry["endpoint"] != "project.update" || entry["status"] != float64(200) {
+				t.Fatalf("unexpected request log fields: %v", entry)
+			}
+			if _, ok := entry["latency_ms"]; !ok {
+				t.Fatalf("missing latency_ms: %v", entry)
+			}
+		}
+	}
+	if !sawRequest {
+		t.Fatalf("no request log entry found in %v", entries)
+	}
+	if strings.Contains(output.String(), "super-secret-key") || strings.Contains(output.String(), "SECRET=value") {
+		t.Fatalf("log output leaked a secret: %s", output.String())
+	}
+}
+
+func TestListDeployments_UsesServiceSpecificEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/deployment.all":
+			if got := r.URL.Query().Get("applicationId"); got != "app-1" {
+				t.Fatalf("unexpected applicationId: %s", got)
+			}
+		case "/deployment.allByCompose":
+			if got := r.URL.Query().Get("composeId"); got != "comp-1" {
+				t.Fatalf("unexpected composeId: %s", got)
+			}
+		default:
+			t.Fatalf("unexpected endpoint: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[{"deploymentId":"dep-1","status":"done","createdAt":"2024-05-01T10:00:00.000Z"}]`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	for _, target := range [][2]string{{"application", "app-1"}, {"compose", "comp-1"}} {
+		deployments, err := c.ListDeployments(context.Background(), target[0], target[1])
+		if err != nil {
+			t.Fatalf("ListDeployments(%s) returned error: %v", target[0], err)
+		}
+		if len(deployments) != 1 || deployments[0].ID != "dep-1" || !deployments[0].Finished() {
+			t.Fatalf("unexpected deployments: %+v", deployments)
+		}
+	}
+}
+
+func TestWaitForNewDeployment_IgnoresKnownDeployments(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		w.WriteHeader(http.StatusOK)
+		switch polls {
+		case 1:
+			_, _ = w.Write([]byte(`[{"deploymentId":"old","status":"done","createdAt":"2024-05-01T10:00:00.000Z"}]`))
+		case 2:
+			_, _ = w.Write([]byte(`[
+				{"deploymentId":"new","status":"running","createdAt":"2024-05-02T10:00:00.000Z"},
+				{"deploymentId":"old","status":"done","createdAt":"2024-05-01T10:00:00.000Z"}
+			]`))
+		default:
+			_, _ = w.Write([]byte(`[
+				{"deploymentId":"new","status":"error","createdAt":"2024-05-02T10:00:00.000Z","errorMessage":"build failed"},
+				{"deploymentId":"old","status":"done","createdAt":"2024-05-01T10:00:00.000Z"}
+			]`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	deployment, err := c.WaitForNewDeployment(context.Background(), "application", "app-1", map[string]bool{"old": true}, time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForNewDeployment returned error: %v", err)
+	}
+	if deployment.ID != "new" || deployment.Status != DeploymentStatusError || deployment.ErrorMessage != "build failed" {
+		t.Fatalf("unexpected deployment: %+v", deployment)
+	}
+	if polls != 3 {
+		t.Fatalf("expected 3 polls, got %d", polls)
+	}
+}
+
+func TestWaitForLatestDeployment_ReturnsNilWhenNeverDeployed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	deployment, err := c.WaitForLatestDeployment(context.Background(), "compose", "comp-1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForLatestDeployment returned error: %v", err)
+	}
+	if deployment != nil {
+		t.Fatalf("expected no deployment, got %+v", deployment)
+	}
+}
+
+func TestWaitForLatestDeployment_WaitsForRunningDeployment(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "running"
+		if polls > 1 {
+			status = "done"
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `[
+			{"deploymentId":"old","status":"error","createdAt":"2024-05-01T10:00:00.000Z"},
+			{"deploymentId":"new","status":%q,"createdAt":"2024-05-02T10:00:00.000Z"}
+		]`, status)
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	deployment, err := c.WaitForLatestDeployment(context.Background(), "application", "app-1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForLatestDeployment returned error: %v", err)
+	}
+	if deployment.ID != "new" || deployment.Status != DeploymentStatusDone {
+		t.Fatalf("unexpected deployment: %+v", deployment)
+	}
+	if polls != 2 {
+		t.Fatalf("expected 2 polls, got %d", polls)
+	}
+}
+
+func TestWaitForServiceStatus_PollsUntilDone(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.one":
+			polls++
+			status := "running"
+			if polls > 2 {
+				status = "done"
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, `{"applicationId":"app-1","applicationStatus":%q}`, status)
+		case "/compose.one":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"composeId":"comp-1","composeStatus":"error"}`))
+		default:
+			t.Fatalf("unexpected endpoint: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	status, err := c.WaitForServiceStatus(context.Background(), "application", "app-1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForServiceStatus returned error: %v", err)
+	}
+	if status != ServiceStatusDone || polls != 3 {
+		t.Fatalf("unexpected result: status=%q polls=%d", status, polls)
+	}
+
+	status, err = c.WaitForServiceStatus(context.Background(), "compose", "comp-1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForServiceStatus returned error: %v", err)
+	}
+	if status != ServiceStatusError {
+		t.Fatalf("expected error status, got %q", status)
+	}
+}
+
+func TestReadDeploymentLogTail_ReadsWebsocketStream(t *testing.T) {
+	server := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		if got := conn.Request().URL.Path; got != "/listen-deployment" {
+			t.Errorf("unexpected websocket path: %s", got)
+		}
+		if got := conn.Request().URL.Query().Get("logPath"); got != "/etc/dokploy/logs/app/build.log" {
+			t.Errorf("unexpected logPath: %s", got)
+		}
+		if got := conn.Request().Header.Get("x-api-key"); got != "test-key" {
+			t.Errorf("unexpected api key header: %s", got)
+		}
+		for _, line := range []string{"step 1\nstep 2", "step 3", "ERROR: build failed"} {
+			_ = websocket.Message.Send(conn, line)
+		}
+		// Keep the stream open like Dokploy's tail -f until the client leaves.
+		var discard string
+		_ = websocket.Message.Receive(conn, &discard)
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL+"/api", "test-key")
+	lines, err := c.ReadDeploymentLogTail(context.Background(), "/etc/dokploy/logs/app/build.log", 2)
+	if err != nil {
+		t.Fatalf("ReadDeploymentLogTail returned error: %v", err)
+	}
+	if !reflect.DeepEqual(lines, []string{"step 3", "ERROR: build failed"}) {
+		t.Fatalf("unexpected log tail: %q", lines)
+	}
+}
+
+func TestCreateServer_SendsPayloadAndParsesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/server.create" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		if payload["ipAddress"] != "10.0.0.5" || payload["sshKeyId"] != "key-1" || payload["serverType"] != "deploy" {
+			t.Fatalf("unexpected payload: %#v", payload)
+		}
+		if payload["port"] != float64(2222) {
+			t.Fatalf("unexpected port: %#v", payload["port"])
+		}
+		_, _ = w.Write([]byte(`{"serverId":"srv-1","name":"worker-1","ipAddress":"10.0.0.5","port":2222,"username":"root","sshKeyId":"key-1","serverType":"deploy","serverStatus":"active"}`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	created, err := c.CreateServer(context.Background(), Server{
+		Name:       "worker-1",
+		IPAddress:  "10.0.0.5",
+		Port:       2222,
+		Username:   "root",
+		SSHKeyID:   "key-1",
+		ServerType: "deploy",
+	})
+	if err != nil {
+		t.Fatalf("CreateServer returned error: %v", err)
+	}
+	if created.ID != "srv-1" || created.ServerStatus != "active" {
+		t.Fatalf("unexpected server: %+v", created)
+	}
+}
+
+func TestCreateServer_FallsBackToListOnBooleanResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/server.create":
+			_, _ = w.Write([]byte(`true`))
+		case "/server.all":
+			_, _ = w.Write([]byte(`[{"serverId":"srv-0","name":"worker-1","ipAddress":"10.0.0.9"},{"serverId":"srv-1","name":"worker-1","ipAddress":"10.0.0.5"}]`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	created, err := c.CreateServer(context.Background(), Server{Name: "worker-1", IPAddress: "10.0.0.5"})
+	if err != nil {
+		t.Fatalf("CreateServer returned error: %v", err)
+	}
+	if created.ID != "srv-1" {
+		t.Fatalf("unexpected server ID: got %q want %q", created.ID, "srv-1")
+	}
+}
+
+func TestSetupServer_ToleratesGatewayTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGatewayTimeout)
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if err := c.SetupServer(context.Background(), "srv-1"); err != nil {
+		t.Fatalf("expected gateway timeout to be tolerated, got %v", err)
+	}
+}
+
+func TestSetupServer_ReturnsOtherErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"SSH key not found"}`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if err := c.SetupServer(context.Background(), "srv-1"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestWaitForServerSetup_PollsUntilReady(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/server.validate" || r.URL.Query().Get("serverId") != "srv-1" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		polls++
+		switch polls {
+		case 1:
+			w.WriteHeader(http.StatusInternalServerError)
+		case 2:
+			_, _ = w.Write([]byte(`{"docker":{"enabled":true,"version":"27.0.1"},"isMainDirectoryInstalled":true,"isSwarmInstalled":false,"isDokployNetworkInstalled":false}`))
+		default:
+			_, _ = w.Write([]byte(`{"docker":{"enabled":true,"version":"27.0.1"},"isMainDirectoryInstalled":true,"isSwarmInstalled":true,"isDokployNetworkInstalled":true}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	c.MaxRetries = 0
+	validation, err := c.WaitForServerSetup(context.Background(), "srv-1", "deploy", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForServerSetup returned error: %v", err)
+	}
+	if !validation.Ready("deploy") || polls != 3 {
+		t.Fatalf("unexpected result: %+v after %d polls", validation, polls)
+	}
+}
+
+func TestServerValidation_BuildServersSkipSwarm(t *testing.T) {
+	var validation ServerValidation
+	validation.Docker.Enabled = true
+	validation.IsMainDirectoryInstalled = true
+
+	if !validation.Ready("build") {
+		t.Fatal("expected build server to be ready without swarm")
+	}
+	if validation.Ready("deploy") {
+		t.Fatal("expected deploy server to require swarm")
+	}
+	if got := validation.Missing("deploy"); len(got) != 2 || got[0] != "swarm" || got[1] != "dokploy-network" {
+		t.Fatalf("unexpected missing steps: %v", got)
+	}
+}
+
+func TestCreateRegistry_SendsPayloadAndParsesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/registry.create" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		if payload["registryName"] != "ghcr" || payload["registryUrl"] != "ghcr.io" || payload["registryType"] != "cloud" {
+			t.Fatalf("unexpected payload: %#v", payload)
+		}
+		if _, ok := payload["serverId"]; ok {
+			t.Fatalf("serverId should be omitted when empty, got %#v", payload["serverId"])
+		}
+		_, _ = w.Write([]byte(`{"registryId":"reg-1","registryName":"ghcr","registryUrl":"ghcr.io","username":"bot","imagePrefix":"acme"}`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	created, err := c.CreateRegistry(context.Background(), Registry{
+		Name:        "ghcr",
+		RegistryURL: "ghcr.io",
+		Username:    "bot",
+		Password:    "secret",
+		ImagePrefix: "acme",
+	})
+	if err != nil {
+		t.Fatalf("CreateRegistry returned error: %v", err)
+	}
+	if created.ID != "reg-1" || created.ImagePrefix != "acme" {
+		t.Fatalf("unexpected registry: %+v", created)
+	}
+}
+
+func TestCreateRegistry_FallsBackToListOnBooleanResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/registry.create":
+			_, _ = w.Write([]byte(`true`))
+		case "/registry.all":
+			_, _ = w.Write([]byte(`[{"registryId":"reg-0","registryName":"dockerhub"},{"registryId":"reg-1","registryName":"ghcr"}]`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	created, err := c.CreateRegistry(context.Background(), Registry{Name: "ghcr"})
+	if err != nil {
+		t.Fatalf("CreateRegistry returned error: %v", err)
+	}
+	if created.ID != "reg-1" {
+		t.Fatalf("unexpected registry ID: got %q want %q", created.ID, "reg-1")
+	}
+}
+
+func TestTestRegistry_FailsOnFalseResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/registry.testRegistry" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`false`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if err := c.TestRegistry(context.Background(), Registry{RegistryURL: "ghcr.io"}); err == nil {
+		t.Fatal("expected an error when the registry login fails")
+	}
+}
+
+func TestUpdateApplication_DetachesRegistry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.update":
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			value, ok := payload["registryId"]
+			if !ok || value != nil {
+				t.Fatalf("expected registryId to be sent as null, got %#v (present: %v)", value, ok)
+			}
+			_, _ = w.Write([]byte(`true`))
+		case "/application.one":
+			_, _ = w.Write([]byte(`{"applicationId":"app-1","name":"web"}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	detach := ""
+	c := NewDokployClient(server.URL, "test-key")
+	if _, err := c.UpdateApplication(context.Background(), Application{ID: "app-1", Name: "web", RegistryID: &detach}); err != nil {
+		t.Fatalf("UpdateApplication returned error: %v", err)
+	}
+}
+
+func TestCreateDatabaseBackup_SendsEngineSpecificID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/backup.create" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		if payload["mysqlId"] != "mysql-1" || payload["databaseType"] != "mysql" || payload["backupType"] != "database" {
+			t.Fatalf("unexpected payload: %#v", payload)
+		}
+		if payload["schedule"] != "0 3 * * *" || payload["keepLatestCount"] != float64(7) || payload["enabled"] != true {
+			t.Fatalf("unexpected schedule settings: %#v", payload)
+		}
+		_, _ = w.Write([]byte(`{"backupId":"bk-1","databaseType":"mysql","database":"shop","mysqlId":"mysql-1","schedule":"0 3 * * *","enabled":true}`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	created, err := c.CreateDatabaseBackup(context.Background(), "mysql-1", DatabaseBackup{
+		DatabaseType:    "mysql",
+		Database:        "shop",
+		Schedule:        "0 3 * * *",
+		Prefix:          "shop",
+		DestinationID:   "dest-1",
+		KeepLatestCount: 7,
+		Enabled:         true,
+	})
+	if err != nil {
+		t.Fatalf("CreateDatabaseBackup returned error: %v", err)
+	}
+	if created.ID != "bk-1" || created.DatabaseID() != "mysql-1" {
+		t.Fatalf("unexpected backup: %+v", created)
+	}
+}
+
+func TestCreateDatabaseBackup_FindsBackupOnDatabaseWhenResponseIsEmpty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/backup.create":
+			w.WriteHeader(http.StatusOK)
+		case "/postgres.one":
+			_, _ = w.Write([]byte(`{"postgresId":"pg-1","backups":[` +
+				`{"backupId":"bk-0","database":"app","prefix":"app","schedule":"0 1 * * *","destinationId":"dest-1"},` +
+				`{"backupId":"bk-1","database":"app","prefix":"app","schedule":"0 3 * * *","destinationId":"dest-1"}]}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	created, err := c.CreateDatabaseBackup(context.Background(), "pg-1", DatabaseBackup{
+		DatabaseType:  "postgres",
+		Database:      "app",
+		Schedule:      "0 3 * * *",
+		Prefix:        "app",
+		DestinationID: "dest-1",
+	})
+	if err != nil {
+		t.Fatalf("CreateDatabaseBackup returned error: %v", err)
+	}
+	if created.ID != "bk-1" || created.DatabaseType != "postgres" {
+		t.Fatalf("unexpected backup: %+v", created)
+	}
+}
+
+func TestCreateDatabaseBackup_RejectsRedis(t *testing.T) {
+	c := NewDokployClient("http://127.0.0.1:0", "test-key")
+	if _, err := c.CreateDatabaseBackup(context.Background(), "redis-1", DatabaseBackup{DatabaseType: "redis"}); err == nil {
+		t.Fatal("expected an error for redis backups")
+	}
+}
+
+func TestUpdateApplication_SendsSwarmSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.update":
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			if payload["memoryLimit"] != "536870912" || payload["replicas"] != float64(2) {
+				t.Fatalf("unexpected resources: %#v", payload)
+			}
+			healthCheck, ok := payload["healthCheckSwarm"].(map[string]interface{})
+			if !ok || healthCheck["Interval"] != float64(30_000_000_000) {
+				t.Fatalf("unexpected healthCheckSwarm: %#v", payload["healthCheckSwarm"])
+			}
+			for _, key := range []string{"placementSwarm", "args"} {
+				if value, ok := payload[key]; !ok || value != nil {
+					t.Fatalf("expected %s to be cleared with null, got %#v (present: %v)", key, value, ok)
+				}
+			}
+			if _, ok := payload["restartPolicySwarm"]; ok {
+				t.Fatalf("restartPolicySwarm should be omitted when unset")
+			}
+			_, _ = w.Write([]byte(`true`))
+		case "/application.one":
+			_, _ = w.Write([]byte(`{"applicationId":"app-1","memoryLimit":"536870912","healthCheckSwarm":{"Test":["CMD","true"],"Interval":30000000000}}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	memoryLimit := "536870912"
+	replicas := int64(2)
+	c := NewDokployClient(server.URL, "test-key")
+	app, err := c.UpdateApplication(context.Background(), Application{
+		ID: "app-1",
+		ApplicationSwarm: ApplicationSwarm{
+			MemoryLimit: &memoryLimit,
+			Replicas:    &replicas,
+			Args:        []string{},
+			HealthCheck: &HealthCheckSwarm{Test: []string{"CMD", "true"}, Interval: 30_000_000_000},
+			Placement:   &PlacementSwarm{},
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpdateApplication returned error: %v", err)
+	}
+	if app.HealthCheck == nil || app.HealthCheck.Interval != 30_000_000_000 || *app.MemoryLimit != memoryLimit {
+		t.Fatalf("unexpected application: %+v", app.ApplicationSwarm)
+	}
+}
+
+func TestSaveBuildType_SendsBuildTypeSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/application.saveBuildType" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		if payload["applicationId"] != "app-1" || payload["buildType"] != "static" || payload["publishDirectory"] != "dist" || payload["isStaticSpa"] != true {
+			t.Fatalf("unexpected payload: %#v", payload)
+		}
+		if value, ok := payload["herokuVersion"]; !ok || value != nil {
+			t.Fatalf("expected herokuVersion to be null, got %#v (present: %v)", value, ok)
+		}
+		_, _ = w.Write([]byte(`true`))
+	}))
+	defer server.Close()
+
+	isStaticSpa := true
+	c := NewDokployClient(server.URL, "test-key")
+	err := c.SaveBuildType(context.Background(), Application{
+		ID:               "app-1",
+		BuildType:        "static",
+		PublishDirectory: "dist",
+		IsStaticSpa:      &isStaticSpa,
+	})
+	if err != nil {
+		t.Fatalf("SaveBuildType returned error: %v", err)
+	}
+}
+
+func TestUpdateApplication_SendsBuildArgs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.update":
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			if payload["buildArgs"] != "A=1\nB=2" {
+				t.Fatalf("unexpected buildArgs: %#v", payload["buildArgs"])
+			}
+			if _, ok := payload["buildSecrets"]; ok {
+				t.Fatalf("buildSecrets should be omitted when unset")
+			}
+			_, _ = w.Write([]byte(`true`))
+		case "/application.one":
+			_, _ = w.Write([]byte(`{"applicationId":"app-1","buildArgs":"A=1\nB=2"}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	buildArgs, err := FormatEnv(map[string]string{"B": "2", "A": "1"})
+	if err != nil {
+		t.Fatalf("FormatEnv returned error: %v", err)
+	}
+	c := NewDokployClient(server.URL, "test-key")
+	app, err := c.UpdateApplication(context.Background(), Application{ID: "app-1", BuildArgs: &buildArgs})
+	if err != nil {
+		t.Fatalf("UpdateApplication returned error: %v", err)
+	}
+	if app.BuildArgs == nil || *app.BuildArgs != buildArgs {
+		t.Fatalf("unexpected buildArgs: %v", app.BuildArgs)
+	}
+}
+
+func TestCreateMount_SendsServiceTypeAndFileContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mounts.create" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		if payload["serviceType"] != "mariadb" || payload["serviceId"] != "maria-1" || payload["type"] != "file" {
+			t.Fatalf("unexpected payload: %#v", payload)
+		}
+		if payload["content"] != "[mysqld]\nmax_connections=200" || payload["filePath"] != "my.cnf" {
+			t.Fatalf("unexpected file payload: %#v", payload)
+		}
+		_, _ = w.Write([]byte(`{"mountId":"mount-1","type":"file","mountPath":"/etc/mysql/conf.d/my.cnf","filePath":"my.cnf","serviceType":"mariadb","mariadbId":"maria-1"}`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	mount, err := c.CreateMount(context.Background(), Mount{
+		ServiceType: "mariadb",
+		ServiceID:   "maria-1",
+		MountType:   "file",
+		MountPath:   "/etc/mysql/conf.d/my.cnf",
+		Content:     "[mysqld]\nmax_connections=200",
+		FilePath:    "my.cnf",
+	})
+	if err != nil {
+		t.Fatalf("CreateMount returned error: %v", err)
+	}
+	if serviceType, serviceID := mount.Target(); mount.ID != "mount-1" || serviceType != "mariadb" || serviceID != "maria-1" {
+		t.Fatalf("unexpected mount: %+v", mount)
+	}
+}
+
+func TestCreateMount_FindsComposeMountWhenResponseIsTrue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mounts.create":
+			_, _ = w.Write([]byte(`true`))
+		case "/compose.one":
+			_, _ = w.Write([]byte(`{"composeId":"comp-1","mounts":[{"mountId":"mount-2","type":"bind","hostPath":"/srv/data","mountPath":"/data","composeId":"comp-1"}]}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	mount, err := c.CreateMount(context.Background(), Mount{
+		ServiceType: "compose",
+		ServiceID:   "comp-1",
+		MountType:   "bind",
+		HostPath:    "/srv/data",
+		MountPath:   "/data/",
+	})
+	if err != nil {
+		t.Fatalf("CreateMount returned error: %v", err)
+	}
+	if mount.ID != "mount-2" {
+		t.Fatalf("expected mount-2, got %+v", mount)
+	}
+}
+
+func TestUpdateMount_ClearsUnusedSources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mounts.update" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		if payload["mountId"] != "mount-1" || payload["type"] != "volume" || payload["volumeName"] != "data" {
+			t.Fatalf("unexpected payload: %#v", payload)
+		}
+		if value, ok := payload["hostPath"]; !ok || value != nil {
+			t.Fatalf("expected hostPath to be null, got %#v (present: %v)", value, ok)
+		}
+		_, _ = w.Write([]byte(`true`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if err := c.UpdateMount(context.Background(), Mount{ID: "mount-1", Type: "volume", VolumeName: "data", MountPath: "/data"}); err != nil {
+		t.Fatalf("UpdateMount returned error: %v", err)
+	}
+}
+
+func TestUpdateEnvironmentEnv_UpdatesSharedVariables(t *testing.T) {
+	environmentEnv := "# shared\nA=1"
+	updateCalls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/environment.one":
+			if got := r.URL.Query().Get("environmentId"); got != "env-1" {
+				t.Fatalf("unexpected environment ID: %q", got)
+			}
+			body, _ := json.Marshal(map[string]string{"environmentId": "env-1", "name": "staging", "env": environmentEnv})
+			_, _ = w.Write(body)
+		case "/environment.update":
+			updateCalls++
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode environment.update payload: %v", err)
+			}
+			if payload["environmentId"] != "env-1" || payload["name"] != "staging" {
+				t.Fatalf("unexpected payload: %#v", payload)
+			}
+			environmentEnv = payload["env"].(string)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	err := c.UpdateEnvironmentEnv(context.Background(), "env-1", func(envMap map[string]string) {
+		envMap["B"] = "2"
+	})
+	if err != nil {
+		t.Fatalf("UpdateEnvironmentEnv returned error: %v", err)
+	}
+	if updateCalls != 1 {
+		t.Fatalf("expected one environment.update call, got %d", updateCalls)
+	}
+	if environmentEnv != "# shared\nA=1\nB=2" {
+		t.Fatalf("unexpected env: %q", environmentEnv)
+	}
+}
+
+func TestCreateDatabase_SendsUserAndDatabaseName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/postgres.create" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		if payload["databaseUser"] != "app" || payload["databaseName"] != "appdb" || payload["appName"] != "main" {
+			t.Fatalf("unexpected payload: %#v", payload)
+		}
+		_, _ = w.Write([]byte(`{"postgresId":"pg-1","name":"main","databaseUser":"app","databaseName":"appdb"}`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	db, err := c.CreateDatabase(context.Background(), Database{EnvironmentID: "env-1", Name: "main", Type: "postgres", DatabaseUser: "app", DatabaseName: "appdb", DatabasePassword: "secret", DockerImage: "postgres:16"})
+	if err != nil {
+		t.Fatalf("CreateDatabase returned error: %v", err)
+	}
+	if db.ID != "pg-1" || db.DatabaseUser != "app" {
+		t.Fatalf("unexpected database: %#v", db)
+	}
+}
+
+func TestUpdateDatabase_UsesEngineEndpoints(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.URL.Path)
+		var payload map[string]interface{}
+		if r.Method == http.MethodPost {
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+		}
+		switch r.URL.Path {
+		case "/mariadb.update":
+			if payload["mariadbId"] != "maria-1" || payload["dockerImage"] != "mariadb:11" || payload["memoryLimit"] != "536870912" {
+				t.Fatalf("unexpected update payload: %#v", payload)
+			}
+			if _, ok := payload["command"]; ok {
+				t.Fatalf("expected unmanaged command to be omitted: %#v", payload)
+			}
+			if payload["cpuLimit"] != nil {
+				t.Fatalf("expected cleared cpuLimit to be null: %#v", payload)
+			}
+			_, _ = w.Write([]byte(`{}`))
+		case "/mariadb.one":
+			_, _ = w.Write([]byte(`{"mariadbId":"maria-1","dockerImage":"mariadb:11","memoryLimit":"536870912"}`))
+		case "/mariadb.changePassword":
+			if payload["mariadbId"] != "maria-1" || payload["password"] != "n3w" {
+				t.Fatalf("unexpected changePassword payload: %#v", payload)
+			}
+			_, _ = w.Write([]byte(`{}`))
+		case "/mariadb.saveExternalPort":
+			if payload["mariadbId"] != "maria-1" || payload["externalPort"] != nil {
+				t.Fatalf("unexpected saveExternalPort payload: %#v", payload)
+			}
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	memoryLimit, cleared := "536870912", ""
+	db, err := c.UpdateDatabase(context.Background(), Database{ID: "maria-1", Type: "mariadb", Name: "shop", DockerImage: "mariadb:11", MemoryLimit: &memoryLimit, CPULimit: &cleared})
+	if err != nil {
+		t.Fatalf("UpdateDatabase returned error: %v", err)
+	}
+	if db.DockerImage != "mariadb:11" || *db.MemoryLimit != memoryLimit {
+		t.Fatalf("unexpected database: %#v", db)
+	}
+	if err := c.ChangeDatabasePassword(context.Background(), "maria-1", "mariadb", "n3w"); err != nil {
+		t.Fatalf("ChangeDatabasePassword returned error: %v", err)
+	}
+	if err := c.SaveDatabaseExternalPort(context.Background(), "maria-1", "mariadb", nil); err != nil {
+		t.Fatalf("SaveDatabaseExternalPort returned error: %v", err)
+	}
+	if len(calls) != 4 {
+		t.Fatalf("unexpected calls: %v", calls)
+	}
+}
+
+func TestStartStopService_UsesServiceEndpoints(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.URL.Path)
+		switch r.URL.Path {
+		case "/redis.one":
+			_, _ = w.Write([]byte(`{"redisId":"redis-1","applicationStatus":"idle"}`))
+		case "/redis.start", "/application.stop", "/compose.start":
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	ctx := context.Background()
+	status, err := c.GetServiceStatus(ctx, "redis", "redis-1")
+	if err != nil || status != ServiceStatusIdle {
+		t.Fatalf("unexpected status %q (%v)", status, err)
+	}
+	if err := c.StartService(ctx, "redis", "redis-1"); err != nil {
+		t.Fatalf("StartService returned error: %v", err)
+	}
+	if err := c.StopService(ctx, "application", "app-1"); err != nil {
+		t.Fatalf("StopService returned error: %v", err)
+	}
+	if err := c.StartService(ctx, "compose", "comp-1"); err != nil {
+		t.Fatalf("StartService returned error: %v", err)
+	}
+	if err := c.StartService(ctx, "queue", "q-1"); err == nil {
+		t.Fatal("expected an error for an unsupported service type")
+	}
+	if len(calls) != 4 {
+		t.Fatalf("unexpected calls: %v", calls)
+	}
+}
+
+func TestCreateRedirect_FindsNewestMatchingRedirect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/redirects.create":
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["applicationId"] != "app-1" || body["permanent"] != true {
+				t.Fatalf("unexpected payload: %v", body)
+			}
+			_, _ = w.Write([]byte(`true`))
+		case "/application.one":
+			_, _ = w.Write([]byte(`{"applicationId":"app-1","redirects":[
+				{"redirectId":"red-1","regex":"^https://www\\.example\\.com/(.*)","replacement":"https://example.com/${1}","permanent":true,"createdAt":"2026-01-01T00:00:00Z"},
+				{"redirectId":"red-2","regex":"^https://www\\.example\\.com/(.*)","replacement":"https://example.com/${1}","permanent":true,"createdAt":"2026-02-01T00:00:00Z"},
+				{"redirectId":"red-3","regex":"^/old/(.*)","replacement":"/new/${1}","permanent":false,"createdAt":"2026-03-01T00:00:00Z"}
+			]}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	redirect, err := c.CreateRedirect(context.Background(), Redirect{
+		ApplicationID: "app-1",
+		Regex:         `^https://www\.example\.com/(.*)`,
+		Replacement:   "https://example.com/${1}",
+		Permanent:     true,
+	})
+	if err != nil {
+		t.Fatalf("CreateRedirect returned error: %v", err)
+	}
+	if redirect.ID != "red-2" {
+		t.Fatalf("expected red-2, got %+v", redirect)
+	}
+}
+
+func TestUpdateRedirect_SendsRedirectID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/redirects.update" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["redirectId"] != "red-1" || body["replacement"] != "/new" || body["permanent"] != false {
+			t.Fatalf("unexpected payload: %v", body)
+		}
+		if _, ok := body["applicationId"]; ok {
+			t.Fatalf("applicationId should not be sent on update: %v", body)
+		}
+		_, _ = w.Write([]byte(`true`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if err := c.UpdateRedirect(context.Background(), Redirect{ID: "red-1", Regex: "^/old", Replacement: "/new"}); err != nil {
+		t.Fatalf("UpdateRedirect returned error: %v", err)
+	}
+}
+
+func TestCreateSecurity_FindsEntryByUsername(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/security.create":
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["applicationId"] != "app-1" || body["username"] != "ops" || body["password"] != "secret" {
+				t.Fatalf("unexpected payload: %v", body)
+			}
+			_, _ = w.Write([]byte(`true`))
+		case "/application.one":
+			_, _ = w.Write([]byte(`{"applicationId":"app-1","security":[
+				{"securityId":"sec-1","username":"admin","password":"other"},
+				{"securityId":"sec-2","username":"ops","password":"secret"}
+			]}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	security, err := c.CreateSecurity(context.Background(), Security{ApplicationID: "app-1", Username: "ops", Password: "secret"})
+	if err != nil {
+		t.Fatalf("CreateSecurity returned error: %v", err)
+	}
+	if security.ID != "sec-2" {
+		t.Fatalf("expected sec-2, got %+v", security)
+	}
+}
+
+func TestCreateCertificate_SendsOrganizationAndServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/user.get":
+			_, _ = w.Write([]byte(`{"userId":"user-1","organizationId":"org-1"}`))
+		case "/certificates.create":
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["organizationId"] != "org-1" || body["serverId"] != "srv-1" || body["autoRenew"] != true || body["privateKey"] != "KEY" {
+				t.Fatalf("unexpected payload: %v", body)
+			}
+			_, _ = w.Write([]byte(`{"certificateId":"cert-1","name":"wildcard"}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	certificate, err := c.CreateCertificate(context.Background(), Certificate{
+		Name:            "wildcard",
+		CertificateData: "CERT",
+		PrivateKey:      "KEY",
+		AutoRenew:       true,
+		ServerID:        "srv-1",
+	})
+	if err != nil {
+		t.Fatalf("CreateCertificate returned error: %v", err)
+	}
+	if certificate.ID != "cert-1" {
+		t.Fatalf("expected cert-1, got %+v", certificate)
+	}
+}
+
+func TestUpdateDomain_ClearsCustomCertResolver(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/domain.update" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		value, ok := body["customCertResolver"]
+		if !ok || value != nil {
+			t.Fatalf("expected a null customCertResolver, got %v", body)
+		}
+		_, _ = w.Write([]byte(`{"domainId":"dom-1","host":"example.com","https":true,"certificateType":"letsencrypt"}`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if _, err := c.UpdateDomain(context.Background(), Domain{ID: "dom-1", Host: "example.com", HTTPS: true, CertificateType: "letsencrypt"}); err != nil {
+		t.Fatalf("UpdateDomain returned error: %v", err)
+	}
+}
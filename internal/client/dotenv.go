@@ -0,0 +1,307 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// EnvFile is a parsed dotenv document as stored by Dokploy for env, build
+// args and build secrets. It keeps comments, blank lines, unparseable lines,
+// the order of the entries and the spelling of every entry that is not
+// changed, so rendering an unmodified document returns the input unchanged.
+//
+// Values are decoded like the dotenv package Dokploy uses: unquoted values end
+// at the first #, quoted values may span several lines, and \n and \r are
+// expanded in double quoted values.
+type EnvFile struct {
+	lines []envLine
+}
+
+type envLine struct {
+	// raw is the original text of the line. Entries with multiline values
+	// span several physical lines joined by \n.
+	raw string
+	// key is empty for comments, blank lines and lines without =.
+	key   string
+	value string
+}
+
+// ParseEnvFile parses a dotenv document.
+func ParseEnvFile(env string) *EnvFile {
+	f := &EnvFile{}
+	if env == "" {
+		return f
+	}
+
+	physical := strings.Split(env, "\n")
+	for i := 0; i < len(physical); i++ {
+		line, consumed := parseEnvLine(physical[i:])
+		f.lines = append(f.lines, line)
+		i += consumed - 1
+	}
+	return f
+}
+
+// parseEnvLine parses the entry starting at lines[0] and returns it together
+// with the number of physical lines it spans.
+func parseEnvLine(lines []string) (envLine, int) {
+	first := lines[0]
+	trimmed := strings.TrimSpace(first)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		return envLine{raw: first}, 1
+	}
+
+	if rest := strings.TrimPrefix(trimmed, "export"); rest != trimmed && rest != "" && (rest[0] == ' ' || rest[0] == '\t') {
+		trimmed = strings.TrimSpace(rest)
+	}
+	eq := strings.Index(trimmed, "=")
+	if eq <= 0 {
+		return envLine{raw: first}, 1
+	}
+	key := strings.TrimSpace(trimmed[:eq])
+	if !isEnvKey(key) {
+		return envLine{raw: first}, 1
+	}
+	value := strings.TrimLeft(strings.TrimRight(trimmed[eq+1:], "\r"), " \t")
+
+	if value != "" && strings.ContainsRune("\"'`", rune(value[0])) {
+		quote := value[0]
+		// Look for the closing quote, continuing on the following lines for
+		// multiline values.
+		body := value[1:]
+		for n := 0; n < len(lines); n++ {
+			if end := closingQuote(body, quote); end >= 0 {
+				decoded := body[:end]
+				if quote == '"' {
+					decoded = strings.NewReplacer(`\n`, "\n", `\r`, "\r").Replace(decoded)
+				}
+				return envLine{raw: strings.Join(lines[:n+1], "\n"), key: key, value: decoded}, n + 1
+			}
+			if n+1 < len(lines) {
+				body += "\n" + lines[n+1]
+			}
+		}
+		// An unterminated quote is read as an unquoted value of this line.
+	}
+
+	if hash := strings.Index(value, "#"); hash >= 0 {
+		value = value[:hash]
+	}
+	return envLine{raw: first, key: key, value: strings.TrimSpace(value)}, 1
+}
+
+// closingQuote returns the index of the quote that closes body, skipping
+// quotes escaped with a backslash.
+func closingQuote(body string, quote byte) int {
+	for i := 0; i < len(body); i++ {
+		switch body[i] {
+		case '\\':
+			if i+1 < len(body) && body[i+1] == quote {
+				i++
+			}
+		case quote:
+			return i
+		}
+	}
+	return -1
+}
+
+func isEnvKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, r := range key {
+		if !(r == '_' || r == '.' || r == '-' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// Get returns the value of key. When a key is defined more than once the
+// last definition wins.
+func (f *EnvFile) Get(key string) (string, bool) {
+	for i := len(f.lines) - 1; i >= 0; i-- {
+		if f.lines[i].key == key {
+			return f.lines[i].value, true
+		}
+	}
+	return "", false
+}
+
+// Keys returns the keys in document order.
+func (f *EnvFile) Keys() []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, line := range f.lines {
+		if line.key != "" && !seen[line.key] {
+			seen[line.key] = true
+			keys = append(keys, line.key)
+		}
+	}
+	return keys
+}
+
+// Map returns the variables of the document.
+func (f *EnvFile) Map() map[string]string {
+	m := make(map[string]string)
+	for _, line := range f.lines {
+		if line.key != "" {
+			m[line.key] = line.value
+		}
+	}
+	return m
+}
+
+// Set assigns value to key. An entry that already has the value is left as
+// written; otherwise the last definition is rewritten in place, earlier
+// duplicates are removed and new keys are appended. It returns an error when
+// value cannot be written so that it parses back unchanged.
+func (f *EnvFile) Set(key, value string) error {
+	if current, ok := f.Get(key); ok && current == value && f.count(key) == 1 {
+		return nil
+	}
+
+	last := -1
+	for i, line := range f.lines {
+		if line.key == key {
+			last = i
+		}
+	}
+	quoted, err := quoteEnvValue(value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	entry := envLine{raw: key + "=" + quoted, key: key, value: value}
+	if last < 0 {
+		// Keep trailing blank lines, such as a final newline, at the end.
+		at := len(f.lines)
+		for at > 0 && f.lines[at-1].key == "" && strings.TrimSpace(f.lines[at-1].raw) == "" {
+			at--
+		}
+		f.lines = append(f.lines[:at], append([]envLine{entry}, f.lines[at:]...)...)
+		return nil
+	}
+
+	if current, _ := f.Get(key); current == value {
+		entry = f.lines[last]
+	}
+	lines := f.lines[:0]
+	for i, line := range f.lines {
+		switch {
+		case i == last:
+			lines = append(lines, entry)
+		case line.key != key:
+			lines = append(lines, line)
+		}
+	}
+	f.lines = lines
+	return nil
+}
+
+// Delete removes every definition of key.
+func (f *EnvFile) Delete(key string) {
+	lines := f.lines[:0]
+	for _, line := range f.lines {
+		if line.key != key {
+			lines = append(lines, line)
+		}
+	}
+	f.lines = lines
+}
+
+// Replace makes the variables of the document equal to m. Keys missing from
+// m are deleted, changed values are rewritten in place and new keys are
+// appended in sorted order. Comments and unchanged entries are kept. It
+// returns the errors of values that cannot be written.
+func (f *EnvFile) Replace(m map[string]string) error {
+	for _, key := range f.Keys() {
+		if _, ok := m[key]; !ok {
+			f.Delete(key)
+		}
+	}
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var errs []error
+	for _, key := range keys {
+		if err := f.Set(key, m[key]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// String renders the document.
+func (f *EnvFile) String() string {
+	raw := make([]string, len(f.lines))
+	for i, line := range f.lines {
+		raw[i] = line.raw
+	}
+	return strings.Join(raw, "\n")
+}
+
+func (f *EnvFile) count(key string) int {
+	n := 0
+	for _, line := range f.lines {
+		if line.key == key {
+			n++
+		}
+	}
+	return n
+}
+
+// quoteEnvValue renders value so it parses back to itself. Plain values are
+// written unquoted; everything else uses the first quote style that can hold
+// the value literally. Quoted values are not unescaped, so a value that no
+// quote style can hold is an error.
+func quoteEnvValue(value string) (string, error) {
+	plain := value == "" || (strings.TrimSpace(value) == value &&
+		!strings.ContainsAny(value, "#\n\r") &&
+		!strings.ContainsRune("\"'`", rune(value[0])))
+	if plain {
+		return value, nil
+	}
+	for _, quote := range []string{"'", "\"", "`"} {
+		if canQuoteEnvValue(value, quote) {
+			return quote + value + quote, nil
+		}
+	}
+	return "", fmt.Errorf("value needs quoting but no quote style preserves it: it contains every quote character, ends with a backslash or has whitespace before its first line break")
+}
+
+// canQuoteEnvValue reports whether value parses back to itself when wrapped
+// in quote.
+func canQuoteEnvValue(value, quote string) bool {
+	// A trailing backslash would escape the closing quote.
+	if strings.Contains(value, quote) || strings.HasSuffix(value, `\`) {
+		return false
+	}
+	// The first line of an entry is trimmed before it is parsed.
+	if first, _, multiline := strings.Cut(value, "\n"); multiline && strings.TrimRightFunc(first, unicode.IsSpace) != first {
+		return false
+	}
+	// Double quoted values expand \n and \r.
+	if quote == "\"" && (strings.Contains(value, `\n`) || strings.Contains(value, `\r`)) {
+		return false
+	}
+	return true
+}
+
+// updateEnv applies updateFn to the variables of env and renders the result,
+// keeping comments, ordering and the spelling of unchanged entries.
+func updateEnv(env string, updateFn func(envMap map[string]string)) (string, error) {
+	f := ParseEnvFile(env)
+	envMap := f.Map()
+	updateFn(envMap)
+	if err := f.Replace(envMap); err != nil {
+		return "", err
+	}
+	return f.String(), nil
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testEnvDocument = `# Database
+export DATABASE_URL=postgres://db:5432/app
+PASSWORD="p#ss word" # inline comment
+SINGLE='single # quoted' 
+EMPTY=
+URL=https://example.com/#anchor
+not a variable
+
+PRIVATE_KEY="-----BEGIN KEY-----
+MIIEvQ==
+-----END KEY-----"
+ESCAPED="line1\nline2"
+RAW='line1\nline2'
+`
+
+func TestParseEnvFile_DecodesValues(t *testing.T) {
+	got := ParseEnvFile(testEnvDocument).Map()
+	want := map[string]string{
+		"DATABASE_URL": "postgres://db:5432/app",
+		"PASSWORD":     "p#ss word",
+		"SINGLE":       "single # quoted",
+		"EMPTY":        "",
+		"URL":          "https://example.com/",
+		"PRIVATE_KEY":  "-----BEGIN KEY-----\nMIIEvQ==\n-----END KEY-----",
+		"ESCAPED":      "line1\nline2",
+		"RAW":          `line1\nline2`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseEnvFile().Map() = %#v, want %#v", got, want)
+	}
+}
+
+func TestParseEnvFile_RoundTripsUnchangedDocument(t *testing.T) {
+	if got := ParseEnvFile(testEnvDocument).String(); got != testEnvDocument {
+		t.Fatalf("round trip changed the document:\n%s", got)
+	}
+	if got, err := updateEnv(testEnvDocument, func(map[string]string) {}); err != nil || got != testEnvDocument {
+		t.Fatalf("no-op update changed the document (%v):\n%s", err, got)
+	}
+}
+
+func TestParseEnvFile_KeepsOrder(t *testing.T) {
+	keys := ParseEnvFile("B=1\nA=2\nC=3\nA=4").Keys()
+	if !reflect.DeepEqual(keys, []string{"B", "A", "C"}) {
+		t.Fatalf("unexpected key order: %v", keys)
+	}
+	if value, _ := ParseEnvFile("A=2\nA=4").Get("A"); value != "4" {
+		t.Fatalf("expected the last definition to win, got %q", value)
+	}
+}
+
+func TestUpdateEnv_OnlyRewritesChangedKeys(t *testing.T) {
+	env := "# managed by ops\nZED=1\nexport KEEP=\"quoted\" # note\nOLD=gone\nNAME=old\n"
+	got, err := updateEnv(env, func(m map[string]string) {
+		m["NAME"] = "new value"
+		m["ADDED"] = "x"
+		delete(m, "OLD")
+	})
+	if err != nil {
+		t.Fatalf("updateEnv returned error: %v", err)
+	}
+	want := "# managed by ops\nZED=1\nexport KEEP=\"quoted\" # note\nNAME=new value\nADDED=x\n"
+	if got != want {
+		t.Fatalf("updateEnv() = %q, want %q", got, want)
+	}
+}
+
+func TestUpdateEnv_CollapsesDuplicatesOfChangedKey(t *testing.T) {
+	got, err := updateEnv("A=1\nB=2\nA=3", func(m map[string]string) {
+		m["A"] = "4"
+	})
+	if err != nil {
+		t.Fatalf("updateEnv returned error: %v", err)
+	}
+	if got != "B=2\nA=4" {
+		t.Fatalf("unexpected document: %q", got)
+	}
+}
+
+func TestFormatEnv_QuotesValuesThatNeedIt(t *testing.T) {
+	values := map[string]string{
+		"PLAIN":     "value",
+		"EMPTY":     "",
+		"HASH":      "a#b",
+		"SPACES":    " padded ",
+		"MULTILINE": "-----BEGIN KEY-----\nabc\n-----END KEY-----",
+		"QUOTE":     "it's",
+		"BOTH":      `it's "quoted"`,
+		"BACKSLASH": `C:\path #1`,
+	}
+	formatted, err := FormatEnv(values)
+	if err != nil {
+		t.Fatalf("FormatEnv returned error: %v", err)
+	}
+	if got := ParseEnv(formatted); !reflect.DeepEqual(got, values) {
+		t.Fatalf("FormatEnv output did not parse back:\n%s\ngot %#v", formatted, got)
+	}
+	if got, _ := FormatEnv(map[string]string{"B": "2", "A": "1"}); got != "A=1\nB=2" {
+		t.Fatalf("expected sorted plain output, got %q", got)
+	}
+}
+
+func TestEnvFileSet_RoundTripsValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{name: "plain", value: "value"},
+		{name: "trailing backslash", value: `C:\dir\`},
+		{name: "hash", value: "a#b"},
+		{name: "hash and trailing backslash", value: `#a\`, wantErr: true},
+		{name: "space hash and trailing backslash", value: `x #y\`, wantErr: true},
+		{name: "hash and escaped double quote", value: `#a\"b`},
+		{name: "single quote and escaped double quote", value: `it's \"x\"`},
+		{name: "single quote and backslash", value: `it's C:\dir`},
+		{name: "single quote and literal newline escape", value: `it's a\nb`},
+		{name: "every quote character", value: "'\"`#", wantErr: true},
+		{name: "multiline", value: "line1\nline2"},
+		{name: "multiline with padded first line", value: "line1 \nline2", wantErr: true},
+		{name: "padded", value: " padded "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := ParseEnvFile("# comment\nOTHER=1\n")
+			err := f.Set("KEY", tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, wrote %q", f.String())
+				}
+				if value, ok := ParseEnvFile(f.String()).Get("KEY"); ok {
+					t.Fatalf("expected the document to be left unchanged, got KEY=%q", value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Set returned error: %v", err)
+			}
+			parsed := ParseEnvFile(f.String())
+			if got, _ := parsed.Get("KEY"); got != tt.value {
+				t.Fatalf("value did not round trip:\n%s\ngot %q, want %q", f.String(), got, tt.value)
+			}
+			if got, _ := parsed.Get("OTHER"); got != "1" {
+				t.Fatalf("other entries changed:\n%s", f.String())
+			}
+		})
+	}
+}
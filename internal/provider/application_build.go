@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+// applicationBuildTypes are the build types Dokploy supports for applications.
+var applicationBuildTypes = []string{"nixpacks", "dockerfile", "heroku_buildpacks", "paketo_buildpacks", "static", "railpack"}
+
+// applicationBuildTypeFields maps build type specific attributes to the build
+// types that use them.
+var applicationBuildTypeFields = []struct {
+	name       string
+	buildTypes []string
+	value      func(ApplicationResourceModel) attr.Value
+}{
+	{"heroku_version", []string{"heroku_buildpacks"}, func(m ApplicationResourceModel) attr.Value { return m.HerokuVersion }},
+	{"publish_directory", []string{"nixpacks", "static"}, func(m ApplicationResourceModel) attr.Value { return m.PublishDirectory }},
+	{"is_static_spa", []string{"static"}, func(m ApplicationResourceModel) attr.Value { return m.IsStaticSpa }},
+	{"railpack_version", []string{"railpack"}, func(m ApplicationResourceModel) attr.Value { return m.RailpackVersion }},
+}
+
+// validateApplicationBuild checks the build type and its settings at plan
+// time. Unknown values are skipped and checked once they are known.
+func validateApplicationBuild(config ApplicationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if config.BuildType.IsUnknown() {
+		return diags
+	}
+	buildType := "nixpacks"
+	if !config.BuildType.IsNull() {
+		buildType = config.BuildType.ValueString()
+		if !containsString(applicationBuildTypes, buildType) {
+			diags.AddAttributeError(path.Root("build_type"), "Invalid Build Configuration",
+				fmt.Sprintf("build_type must be one of %s, got %q.", strings.Join(applicationBuildTypes, ", "), buildType))
+			return diags
+		}
+	}
+
+	for _, field := range applicationBuildTypeFields {
+		value := field.value(config)
+		if value.IsNull() || value.IsUnknown() || containsString(field.buildTypes, buildType) {
+			continue
+		}
+		diags.AddAttributeError(path.Root(field.name), "Invalid Build Configuration",
+			fmt.Sprintf("%s is only used with build_type %s, but build_type is %q.", field.name, strings.Join(field.buildTypes, " or "), buildType))
+	}
+
+	if !config.BuildSecrets.IsNull() && !config.BuildSecrets.IsUnknown() && buildType != "dockerfile" {
+		diags.AddAttributeWarning(path.Root("build_secrets"), "Build Secrets Not Used",
+			fmt.Sprintf("Dokploy only passes build_secrets to dockerfile builds; build_type is %q.", buildType))
+	}
+
+	return diags
+}
+
+// applicationUsesBuildTypeSettings reports whether the build type settings
+// need to be saved with application.saveBuildType, which the regular update
+// does not cover.
+func applicationUsesBuildTypeSettings(model ApplicationResourceModel) bool {
+	switch model.BuildType.ValueString() {
+	case "heroku_buildpacks", "paketo_buildpacks", "static", "railpack":
+		return true
+	}
+	for _, field := range applicationBuildTypeFields {
+		if value := field.value(model); !value.IsNull() && !value.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
+// applicationBuildSettings fills the build type settings of app from the plan.
+func applicationBuildSettings(app *client.Application, plan ApplicationResourceModel) {
+	app.HerokuVersion = optionalStringFromPlan(plan.HerokuVersion)
+	app.PublishDirectory = optionalStringFromPlan(plan.PublishDirectory)
+	app.IsStaticSpa = optionalBoolPointerFromPlan(plan.IsStaticSpa)
+	app.RailpackVersion = optionalStringFromPlan(plan.RailpackVersion)
+}
+
+// expandBuildVariables renders build_args or build_secrets for Dokploy. A map
+// that was removed from the configuration is sent empty to clear it.
+func expandBuildVariables(ctx context.Context, plan, state types.Map) (*string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if plan.IsUnknown() {
+		return nil, diags
+	}
+	if plan.IsNull() {
+		if state.IsNull() || state.IsUnknown() {
+			return nil, diags
+		}
+		empty := ""
+		return &empty, diags
+	}
+
+	values := map[string]string{}
+	diags.Append(plan.ElementsAs(ctx, &values, false)...)
+	formatted, err := client.FormatEnv(values)
+	if err != nil {
+		diags.AddError("Invalid Build Variable", err.Error())
+		return nil, diags
+	}
+	return &formatted, diags
+}
+
+// flattenBuildVariables refreshes build_args or build_secrets from Dokploy when
+// they are managed.
+func flattenBuildVariables(ctx context.Context, value *string, state types.Map) (types.Map, diag.Diagnostics) {
+	if state.IsNull() {
+		return state, nil
+	}
+	env := ""
+	if value != nil {
+		env = *value
+	}
+	return types.MapValueFrom(ctx, types.StringType, client.ParseEnv(env))
+}
+
+// flattenBuildTypeString refreshes a build type setting when it is managed.
+func flattenBuildTypeString(value string, state types.String) types.String {
+	if state.IsNull() {
+		return state
+	}
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testApplicationBuildModel(buildType string) ApplicationResourceModel {
+	model := ApplicationResourceModel{
+		BuildType:        types.StringValue(buildType),
+		BuildArgs:        types.MapNull(types.StringType),
+		BuildSecrets:     types.MapNull(types.StringType),
+		HerokuVersion:    types.StringNull(),
+		PublishDirectory: types.StringNull(),
+		IsStaticSpa:      types.BoolNull(),
+		RailpackVersion:  types.StringNull(),
+	}
+	if buildType == "" {
+		model.BuildType = types.StringNull()
+	}
+	return model
+}
+
+func TestValidateApplicationBuild_RejectsUnknownBuildType(t *testing.T) {
+	diags := validateApplicationBuild(testApplicationBuildModel("buildpacks"))
+	if !diags.HasError() {
+		t.Fatal("expected an error for an unsupported build type")
+	}
+}
+
+func TestValidateApplicationBuild_RejectsSettingsOfOtherBuildTypes(t *testing.T) {
+	config := testApplicationBuildModel("dockerfile")
+	config.HerokuVersion = types.StringValue("24")
+	config.IsStaticSpa = types.BoolValue(true)
+
+	diags := validateApplicationBuild(config)
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("expected 2 errors, got %v", diags)
+	}
+}
+
+func TestValidateApplicationBuild_AcceptsMatchingSettings(t *testing.T) {
+	config := testApplicationBuildModel("static")
+	config.PublishDirectory = types.StringValue("dist")
+	config.IsStaticSpa = types.BoolValue(true)
+	if diags := validateApplicationBuild(config); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	// publish_directory is also valid for the default nixpacks build type.
+	config = testApplicationBuildModel("")
+	config.PublishDirectory = types.StringValue("dist")
+	if diags := validateApplicationBuild(config); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+}
+
+func TestValidateApplicationBuild_WarnsAboutUnusedBuildSecrets(t *testing.T) {
+	config := testApplicationBuildModel("nixpacks")
+	config.BuildSecrets = types.MapValueMust(types.StringType, map[string]attr.Value{"NPM_TOKEN": types.StringValue("secret")})
+
+	diags := validateApplicationBuild(config)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+
+	config.BuildType = types.StringValue("dockerfile")
+	if diags := validateApplicationBuild(config); len(diags) != 0 {
+		t.Fatalf("expected no diagnostics for dockerfile builds, got %v", diags)
+	}
+}
+
+func TestValidateApplicationBuild_SkipsUnknownBuildType(t *testing.T) {
+	config := testApplicationBuildModel("")
+	config.BuildType = types.StringUnknown()
+	config.RailpackVersion = types.StringValue("0.2.2")
+	if diags := validateApplicationBuild(config); len(diags) != 0 {
+		t.Fatalf("expected no diagnostics, got %v", diags)
+	}
+}
+
+func TestExpandBuildVariables(t *testing.T) {
+	ctx := context.Background()
+	args := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"NODE_ENV": types.StringValue("production"),
+		"APP_ENV":  types.StringValue("prod"),
+	})
+
+	tests := []struct {
+		name  string
+		plan  types.Map
+		state types.Map
+		want  string
+		isNil bool
+	}{
+		{"unset", types.MapNull(types.StringType), types.MapNull(types.StringType), "", true},
+		{"removed", types.MapNull(types.StringType), args, "", false},
+		{"set", args, types.MapNull(types.StringType), "APP_ENV=prod\nNODE_ENV=production", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := expandBuildVariables(ctx, tt.plan, tt.state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if (got == nil) != tt.isNil || derefString(got) != tt.want {
+				t.Fatalf("expandBuildVariables() = %q (nil: %v), want %q (nil: %v)", derefString(got), got == nil, tt.want, tt.isNil)
+			}
+		})
+	}
+}
+
+func TestFlattenBuildVariables_KeepsUnmanagedNull(t *testing.T) {
+	value := "NODE_ENV=production"
+	got, diags := flattenBuildVariables(context.Background(), &value, types.MapNull(types.StringType))
+	if diags.HasError() || !got.IsNull() {
+		t.Fatalf("expected null map, got %v (%v)", got, diags)
+	}
+}
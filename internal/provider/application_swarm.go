@@ -0,0 +1,740 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+// ApplicationResourcesModel is the resources attribute of an application.
+type ApplicationResourcesModel struct {
+	MemoryReservation types.Int64 `tfsdk:"memory_reservation"`
+	MemoryLimit       types.Int64 `tfsdk:"memory_limit"`
+	CPUReservation    types.Int64 `tfsdk:"cpu_reservation"`
+	CPULimit          types.Int64 `tfsdk:"cpu_limit"`
+}
+
+type ApplicationHealthCheckModel struct {
+	Test        types.List   `tfsdk:"test"`
+	Interval    types.String `tfsdk:"interval"`
+	Timeout     types.String `tfsdk:"timeout"`
+	StartPeriod types.String `tfsdk:"start_period"`
+	Retries     types.Int64  `tfsdk:"retries"`
+}
+
+type ApplicationRestartPolicyModel struct {
+	Condition   types.String `tfsdk:"condition"`
+	Delay       types.String `tfsdk:"delay"`
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+	Window      types.String `tfsdk:"window"`
+}
+
+type ApplicationPlacementModel struct {
+	Constraints types.List  `tfsdk:"constraints"`
+	Preferences types.List  `tfsdk:"preferences"`
+	MaxReplicas types.Int64 `tfsdk:"max_replicas"`
+}
+
+// ApplicationUpdateConfigModel is shared by update_config and rollback_config.
+type ApplicationUpdateConfigModel struct {
+	Parallelism     types.Int64   `tfsdk:"parallelism"`
+	Delay           types.String  `tfsdk:"delay"`
+	FailureAction   types.String  `tfsdk:"failure_action"`
+	Monitor         types.String  `tfsdk:"monitor"`
+	MaxFailureRatio types.Float64 `tfsdk:"max_failure_ratio"`
+	Order           types.String  `tfsdk:"order"`
+}
+
+type ApplicationNetworkModel struct {
+	Target     types.String `tfsdk:"target"`
+	Aliases    types.List   `tfsdk:"aliases"`
+	DriverOpts types.Map    `tfsdk:"driver_opts"`
+}
+
+var applicationResourcesAttrTypes = map[string]attr.Type{
+	"memory_reservation": types.Int64Type,
+	"memory_limit":       types.Int64Type,
+	"cpu_reservation":    types.Int64Type,
+	"cpu_limit":          types.Int64Type,
+}
+
+var applicationHealthCheckAttrTypes = map[string]attr.Type{
+	"test":         types.ListType{ElemType: types.StringType},
+	"interval":     types.StringType,
+	"timeout":      types.StringType,
+	"start_period": types.StringType,
+	"retries":      types.Int64Type,
+}
+
+var applicationRestartPolicyAttrTypes = map[string]attr.Type{
+	"condition":    types.StringType,
+	"delay":        types.StringType,
+	"max_attempts": types.Int64Type,
+	"window":       types.StringType,
+}
+
+var applicationPlacementAttrTypes = map[string]attr.Type{
+	"constraints":  types.ListType{ElemType: types.StringType},
+	"preferences":  types.ListType{ElemType: types.StringType},
+	"max_replicas": types.Int64Type,
+}
+
+var applicationUpdateConfigAttrTypes = map[string]attr.Type{
+	"parallelism":       types.Int64Type,
+	"delay":             types.StringType,
+	"failure_action":    types.StringType,
+	"monitor":           types.StringType,
+	"max_failure_ratio": types.Float64Type,
+	"order":             types.StringType,
+}
+
+var applicationNetworkObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"target":      types.StringType,
+		"aliases":     types.ListType{ElemType: types.StringType},
+		"driver_opts": types.MapType{ElemType: types.StringType},
+	},
+}
+
+func applicationSwarmAttributes() map[string]schema.Attribute {
+	durationDescription := " as a Go duration such as \"30s\"."
+	updateConfigAttributes := func() map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"parallelism": schema.Int64Attribute{
+				Required:    true,
+				Description: "Number of tasks updated at the same time. 0 updates all tasks at once.",
+			},
+			"delay": schema.StringAttribute{
+				Optional:    true,
+				Description: "Wait between updating batches of tasks," + durationDescription,
+			},
+			"failure_action": schema.StringAttribute{
+				Optional:    true,
+				Description: "Action when a task fails to update: pause, continue or rollback (rollback is not valid in rollback_config).",
+			},
+			"monitor": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long each task is monitored for failure after it was updated," + durationDescription,
+			},
+			"max_failure_ratio": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Fraction of tasks that may fail before the failure action is taken.",
+			},
+			"order": schema.StringAttribute{
+				Optional:    true,
+				Description: "Update order: stop-first or start-first.",
+			},
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"resources": resourceLimitsAttribute("Memory and CPU reservations and limits of the application's containers."),
+		"replicas": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Number of replicas in replicated mode. Removing it resets the application to 1 replica.",
+		},
+		"mode": schema.StringAttribute{
+			Optional:    true,
+			Description: "Swarm service mode: replicated or global.",
+		},
+		"command": schema.StringAttribute{
+			Optional:    true,
+			Description: "Command that overrides the image entrypoint.",
+		},
+		"args": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "Arguments passed to command.",
+		},
+		"health_check": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Swarm health check of the application's containers.",
+			Attributes: map[string]schema.Attribute{
+				"test": schema.ListAttribute{
+					ElementType: types.StringType,
+					Required:    true,
+					Description: "Health check command, e.g. [\"CMD-SHELL\", \"curl -f http://localhost/ || exit 1\"].",
+				},
+				"interval": schema.StringAttribute{
+					Optional:    true,
+					Description: "Time between checks," + durationDescription,
+				},
+				"timeout": schema.StringAttribute{
+					Optional:    true,
+					Description: "Time a single check may take," + durationDescription,
+				},
+				"start_period": schema.StringAttribute{
+					Optional:    true,
+					Description: "Grace period before failed checks count," + durationDescription,
+				},
+				"retries": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Consecutive failures needed to report the container as unhealthy.",
+				},
+			},
+		},
+		"restart_policy": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Swarm restart policy of the application's tasks.",
+			Attributes: map[string]schema.Attribute{
+				"condition": schema.StringAttribute{
+					Optional:    true,
+					Description: "When to restart: none, on-failure or any.",
+				},
+				"delay": schema.StringAttribute{
+					Optional:    true,
+					Description: "Wait between restart attempts," + durationDescription,
+				},
+				"max_attempts": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Maximum restart attempts before giving up.",
+				},
+				"window": schema.StringAttribute{
+					Optional:    true,
+					Description: "Window used to evaluate the restart policy," + durationDescription,
+				},
+			},
+		},
+		"placement": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Swarm placement of the application's tasks.",
+			Attributes: map[string]schema.Attribute{
+				"constraints": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Placement constraints, e.g. node.role==worker.",
+				},
+				"preferences": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Spread descriptors used to spread tasks, e.g. node.labels.zone.",
+				},
+				"max_replicas": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Maximum number of tasks per node.",
+				},
+			},
+		},
+		"update_config": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "How Swarm rolls out updates of the application.",
+			Attributes:  updateConfigAttributes(),
+		},
+		"rollback_config": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "How Swarm rolls back failed updates of the application.",
+			Attributes:  updateConfigAttributes(),
+		},
+		"networks": schema.ListNestedAttribute{
+			Optional:    true,
+			Description: "Docker networks the application's service is attached to.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"target": schema.StringAttribute{
+						Required:    true,
+						Description: "Network name or ID.",
+					},
+					"aliases": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"driver_opts": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceLimitsAttribute returns the resources attribute shared by
+// applications and databases.
+func resourceLimitsAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"memory_reservation": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Memory reserved for the container, in bytes.",
+			},
+			"memory_limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Hard memory limit, in bytes.",
+			},
+			"cpu_reservation": schema.Int64Attribute{
+				Optional:    true,
+				Description: "CPU reserved for the container, in nano CPUs (1000000000 is one CPU).",
+			},
+			"cpu_limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "CPU limit, in nano CPUs (1000000000 is one CPU).",
+			},
+		},
+	}
+}
+
+// validateApplicationSwarm checks the Swarm mode and durations, which Dokploy
+// receives in other formats, before anything is planned.
+func validateApplicationSwarm(ctx context.Context, config ApplicationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if value, ok := configuredString(config.Mode); ok {
+		if mode := strings.ToLower(value); mode != "replicated" && mode != "global" {
+			diags.AddAttributeError(path.Root("mode"), "Invalid Swarm Configuration",
+				fmt.Sprintf("mode must be replicated or global, got %q.", value))
+		}
+	}
+
+	if isConfiguredObject(config.HealthCheck) {
+		var model ApplicationHealthCheckModel
+		diags.Append(config.HealthCheck.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		attrPath := path.Root("health_check")
+		swarmDuration(model.Interval, attrPath.AtName("interval"), &diags)
+		swarmDuration(model.Timeout, attrPath.AtName("timeout"), &diags)
+		swarmDuration(model.StartPeriod, attrPath.AtName("start_period"), &diags)
+	}
+	if isConfiguredObject(config.RestartPolicy) {
+		var model ApplicationRestartPolicyModel
+		diags.Append(config.RestartPolicy.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		attrPath := path.Root("restart_policy")
+		swarmDuration(model.Delay, attrPath.AtName("delay"), &diags)
+		swarmDuration(model.Window, attrPath.AtName("window"), &diags)
+	}
+	validateUpdateConfigDurations(ctx, config.UpdateConfig, path.Root("update_config"), &diags)
+	validateUpdateConfigDurations(ctx, config.RollbackConfig, path.Root("rollback_config"), &diags)
+
+	return diags
+}
+
+func validateUpdateConfigDurations(ctx context.Context, value types.Object, attrPath path.Path, diags *diag.Diagnostics) {
+	if !isConfiguredObject(value) {
+		return
+	}
+	var model ApplicationUpdateConfigModel
+	diags.Append(value.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	swarmDuration(model.Delay, attrPath.AtName("delay"), diags)
+	swarmDuration(model.Monitor, attrPath.AtName("monitor"), diags)
+}
+
+// expandApplicationSwarm converts the configured resource limits and Swarm
+// settings into their Dokploy representation. Unset attributes stay nil so
+// Dokploy keeps its current values.
+func expandApplicationSwarm(ctx context.Context, plan ApplicationResourceModel) (client.ApplicationSwarm, diag.Diagnostics) {
+	var swarm client.ApplicationSwarm
+	var diags diag.Diagnostics
+
+	if isConfiguredObject(plan.Resources) {
+		var resources ApplicationResourcesModel
+		diags.Append(plan.Resources.As(ctx, &resources, basetypes.ObjectAsOptions{})...)
+		swarm.MemoryReservation = int64StringPointer(resources.MemoryReservation)
+		swarm.MemoryLimit = int64StringPointer(resources.MemoryLimit)
+		swarm.CPUReservation = int64StringPointer(resources.CPUReservation)
+		swarm.CPULimit = int64StringPointer(resources.CPULimit)
+	}
+
+	swarm.Replicas = optionalInt64PointerFromPlan(plan.Replicas)
+	if value, ok := configuredString(plan.Command); ok {
+		swarm.Command = &value
+	}
+	if !plan.Args.IsNull() && !plan.Args.IsUnknown() {
+		swarm.Args = []string{}
+		diags.Append(plan.Args.ElementsAs(ctx, &swarm.Args, false)...)
+	}
+
+	if value, ok := configuredString(plan.Mode); ok {
+		switch strings.ToLower(value) {
+		case "replicated":
+			replicated := &client.ReplicatedModeSwarm{}
+			if swarm.Replicas != nil {
+				replicated.Replicas = *swarm.Replicas
+			}
+			swarm.Mode = &client.ModeSwarm{Replicated: replicated}
+		case "global":
+			swarm.Mode = &client.ModeSwarm{Global: &struct{}{}}
+		}
+	}
+
+	if isConfiguredObject(plan.HealthCheck) {
+		var model ApplicationHealthCheckModel
+		diags.Append(plan.HealthCheck.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		healthCheck := &client.HealthCheckSwarm{Retries: model.Retries.ValueInt64()}
+		diags.Append(model.Test.ElementsAs(ctx, &healthCheck.Test, false)...)
+		attrPath := path.Root("health_check")
+		healthCheck.Interval = swarmDuration(model.Interval, attrPath.AtName("interval"), &diags)
+		healthCheck.Timeout = swarmDuration(model.Timeout, attrPath.AtName("timeout"), &diags)
+		healthCheck.StartPeriod = swarmDuration(model.StartPeriod, attrPath.AtName("start_period"), &diags)
+		swarm.HealthCheck = healthCheck
+	}
+
+	if isConfiguredObject(plan.RestartPolicy) {
+		var model ApplicationRestartPolicyModel
+		diags.Append(plan.RestartPolicy.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		attrPath := path.Root("restart_policy")
+		swarm.RestartPolicy = &client.RestartPolicySwarm{
+			Condition:   optionalStringFromPlan(model.Condition),
+			Delay:       swarmDuration(model.Delay, attrPath.AtName("delay"), &diags),
+			MaxAttempts: model.MaxAttempts.ValueInt64(),
+			Window:      swarmDuration(model.Window, attrPath.AtName("window"), &diags),
+		}
+	}
+
+	if isConfiguredObject(plan.Placement) {
+		var model ApplicationPlacementModel
+		diags.Append(plan.Placement.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		placement := &client.PlacementSwarm{MaxReplicas: model.MaxReplicas.ValueInt64()}
+		if !model.Constraints.IsNull() && !model.Constraints.IsUnknown() {
+			diags.Append(model.Constraints.ElementsAs(ctx, &placement.Constraints, false)...)
+		}
+		if !model.Preferences.IsNull() && !model.Preferences.IsUnknown() {
+			var descriptors []string
+			diags.Append(model.Preferences.ElementsAs(ctx, &descriptors, false)...)
+			for _, descriptor := range descriptors {
+				var preference client.PlacementPreferenceSwarm
+				preference.Spread.SpreadDescriptor = descriptor
+				placement.Preferences = append(placement.Preferences, preference)
+			}
+		}
+		swarm.Placement = placement
+	}
+
+	swarm.UpdateConfig = expandUpdateConfig(ctx, plan.UpdateConfig, path.Root("update_config"), &diags)
+	swarm.RollbackConfig = expandUpdateConfig(ctx, plan.RollbackConfig, path.Root("rollback_config"), &diags)
+
+	if !plan.Networks.IsNull() && !plan.Networks.IsUnknown() {
+		var models []ApplicationNetworkModel
+		diags.Append(plan.Networks.ElementsAs(ctx, &models, false)...)
+		swarm.Networks = []client.NetworkSwarm{}
+		for _, model := range models {
+			network := client.NetworkSwarm{Target: model.Target.ValueString()}
+			if !model.Aliases.IsNull() && !model.Aliases.IsUnknown() {
+				diags.Append(model.Aliases.ElementsAs(ctx, &network.Aliases, false)...)
+			}
+			if !model.DriverOpts.IsNull() && !model.DriverOpts.IsUnknown() {
+				diags.Append(model.DriverOpts.ElementsAs(ctx, &network.DriverOpts, false)...)
+			}
+			swarm.Networks = append(swarm.Networks, network)
+		}
+	}
+
+	return swarm, diags
+}
+
+func expandUpdateConfig(ctx context.Context, value types.Object, attrPath path.Path, diags *diag.Diagnostics) *client.UpdateConfigSwarm {
+	if !isConfiguredObject(value) {
+		return nil
+	}
+	var model ApplicationUpdateConfigModel
+	diags.Append(value.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	return &client.UpdateConfigSwarm{
+		Parallelism:     model.Parallelism.ValueInt64(),
+		Delay:           swarmDuration(model.Delay, attrPath.AtName("delay"), diags),
+		FailureAction:   optionalStringFromPlan(model.FailureAction),
+		Monitor:         swarmDuration(model.Monitor, attrPath.AtName("monitor"), diags),
+		MaxFailureRatio: model.MaxFailureRatio.ValueFloat64(),
+		Order:           optionalStringFromPlan(model.Order),
+	}
+}
+
+// clearRemovedApplicationSwarm marks settings that were removed from the
+// configuration so the update clears them in Dokploy.
+func clearRemovedApplicationSwarm(swarm *client.ApplicationSwarm, plan, state ApplicationResourceModel) {
+	empty := ""
+	if plan.Resources.IsNull() && !state.Resources.IsNull() {
+		swarm.MemoryReservation = &empty
+		swarm.MemoryLimit = &empty
+		swarm.CPUReservation = &empty
+		swarm.CPULimit = &empty
+	}
+	if plan.Replicas.IsNull() && !state.Replicas.IsNull() {
+		one := int64(1)
+		swarm.Replicas = &one
+	}
+	if plan.Command.IsNull() && !state.Command.IsNull() {
+		swarm.Command = &empty
+	}
+	if plan.Args.IsNull() && !state.Args.IsNull() {
+		swarm.Args = []string{}
+	}
+	if plan.Mode.IsNull() && !state.Mode.IsNull() {
+		swarm.Mode = &client.ModeSwarm{}
+	}
+	if plan.HealthCheck.IsNull() && !state.HealthCheck.IsNull() {
+		swarm.HealthCheck = &client.HealthCheckSwarm{}
+	}
+	if plan.RestartPolicy.IsNull() && !state.RestartPolicy.IsNull() {
+		swarm.RestartPolicy = &client.RestartPolicySwarm{}
+	}
+	if plan.Placement.IsNull() && !state.Placement.IsNull() {
+		swarm.Placement = &client.PlacementSwarm{}
+	}
+	if plan.UpdateConfig.IsNull() && !state.UpdateConfig.IsNull() {
+		swarm.UpdateConfig = &client.UpdateConfigSwarm{}
+	}
+	if plan.RollbackConfig.IsNull() && !state.RollbackConfig.IsNull() {
+		swarm.RollbackConfig = &client.UpdateConfigSwarm{}
+	}
+	if plan.Networks.IsNull() && !state.Networks.IsNull() {
+		swarm.Networks = []client.NetworkSwarm{}
+	}
+}
+
+// flattenApplicationSwarm refreshes the Swarm settings tracked in state from
+// Dokploy. Like labels, settings that are not configured are left alone.
+func flattenApplicationSwarm(ctx context.Context, swarm client.ApplicationSwarm, state *ApplicationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !state.Resources.IsNull() {
+		value, d := types.ObjectValue(applicationResourcesAttrTypes, map[string]attr.Value{
+			"memory_reservation": int64FromString(swarm.MemoryReservation),
+			"memory_limit":       int64FromString(swarm.MemoryLimit),
+			"cpu_reservation":    int64FromString(swarm.CPUReservation),
+			"cpu_limit":          int64FromString(swarm.CPULimit),
+		})
+		diags.Append(d...)
+		state.Resources = value
+	}
+
+	if !state.Replicas.IsNull() && swarm.Replicas != nil {
+		state.Replicas = types.Int64Value(*swarm.Replicas)
+	}
+	if !state.Command.IsNull() {
+		state.Command = swarmString(derefString(swarm.Command), state.Command)
+	}
+	if !state.Args.IsNull() {
+		state.Args = swarmList(ctx, swarm.Args, state.Args, &diags)
+	}
+	if !state.Mode.IsNull() {
+		switch {
+		case swarm.Mode != nil && swarm.Mode.Global != nil:
+			state.Mode = types.StringValue("global")
+		case swarm.Mode != nil && swarm.Mode.Replicated != nil:
+			state.Mode = types.StringValue("replicated")
+		default:
+			state.Mode = types.StringNull()
+		}
+	}
+
+	if !state.HealthCheck.IsNull() {
+		if swarm.HealthCheck == nil {
+			state.HealthCheck = types.ObjectNull(applicationHealthCheckAttrTypes)
+		} else {
+			var prior ApplicationHealthCheckModel
+			diags.Append(state.HealthCheck.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			value, d := types.ObjectValue(applicationHealthCheckAttrTypes, map[string]attr.Value{
+				"test":         swarmList(ctx, swarm.HealthCheck.Test, prior.Test, &diags),
+				"interval":     swarmDurationValue(swarm.HealthCheck.Interval, prior.Interval),
+				"timeout":      swarmDurationValue(swarm.HealthCheck.Timeout, prior.Timeout),
+				"start_period": swarmDurationValue(swarm.HealthCheck.StartPeriod, prior.StartPeriod),
+				"retries":      swarmInt64(swarm.HealthCheck.Retries, prior.Retries),
+			})
+			diags.Append(d...)
+			state.HealthCheck = value
+		}
+	}
+
+	if !state.RestartPolicy.IsNull() {
+		if swarm.RestartPolicy == nil {
+			state.RestartPolicy = types.ObjectNull(applicationRestartPolicyAttrTypes)
+		} else {
+			var prior ApplicationRestartPolicyModel
+			diags.Append(state.RestartPolicy.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			value, d := types.ObjectValue(applicationRestartPolicyAttrTypes, map[string]attr.Value{
+				"condition":    swarmString(swarm.RestartPolicy.Condition, prior.Condition),
+				"delay":        swarmDurationValue(swarm.RestartPolicy.Delay, prior.Delay),
+				"max_attempts": swarmInt64(swarm.RestartPolicy.MaxAttempts, prior.MaxAttempts),
+				"window":       swarmDurationValue(swarm.RestartPolicy.Window, prior.Window),
+			})
+			diags.Append(d...)
+			state.RestartPolicy = value
+		}
+	}
+
+	if !state.Placement.IsNull() {
+		if swarm.Placement == nil {
+			state.Placement = types.ObjectNull(applicationPlacementAttrTypes)
+		} else {
+			var prior ApplicationPlacementModel
+			diags.Append(state.Placement.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			descriptors := make([]string, 0, len(swarm.Placement.Preferences))
+			for _, preference := range swarm.Placement.Preferences {
+				descriptors = append(descriptors, preference.Spread.SpreadDescriptor)
+			}
+			value, d := types.ObjectValue(applicationPlacementAttrTypes, map[string]attr.Value{
+				"constraints":  swarmList(ctx, swarm.Placement.Constraints, prior.Constraints, &diags),
+				"preferences":  swarmList(ctx, descriptors, prior.Preferences, &diags),
+				"max_replicas": swarmInt64(swarm.Placement.MaxReplicas, prior.MaxReplicas),
+			})
+			diags.Append(d...)
+			state.Placement = value
+		}
+	}
+
+	if !state.UpdateConfig.IsNull() {
+		state.UpdateConfig = flattenUpdateConfig(ctx, swarm.UpdateConfig, state.UpdateConfig, &diags)
+	}
+	if !state.RollbackConfig.IsNull() {
+		state.RollbackConfig = flattenUpdateConfig(ctx, swarm.RollbackConfig, state.RollbackConfig, &diags)
+	}
+
+	if !state.Networks.IsNull() {
+		var priorNetworks []ApplicationNetworkModel
+		diags.Append(state.Networks.ElementsAs(ctx, &priorNetworks, false)...)
+		networks := make([]ApplicationNetworkModel, 0, len(swarm.Networks))
+		for i, network := range swarm.Networks {
+			var prior ApplicationNetworkModel
+			if i < len(priorNetworks) {
+				prior = priorNetworks[i]
+			} else {
+				prior = ApplicationNetworkModel{
+					Aliases:    types.ListNull(types.StringType),
+					DriverOpts: types.MapNull(types.StringType),
+				}
+			}
+			model := ApplicationNetworkModel{
+				Target:     types.StringValue(network.Target),
+				Aliases:    swarmList(ctx, network.Aliases, prior.Aliases, &diags),
+				DriverOpts: prior.DriverOpts,
+			}
+			if len(network.DriverOpts) > 0 {
+				driverOpts, d := types.MapValueFrom(ctx, types.StringType, network.DriverOpts)
+				diags.Append(d...)
+				model.DriverOpts = driverOpts
+			} else if len(prior.DriverOpts.Elements()) > 0 {
+				model.DriverOpts = types.MapNull(types.StringType)
+			}
+			networks = append(networks, model)
+		}
+		if len(networks) == 0 {
+			state.Networks = types.ListNull(applicationNetworkObjectType)
+		} else {
+			value, d := types.ListValueFrom(ctx, applicationNetworkObjectType, networks)
+			diags.Append(d...)
+			state.Networks = value
+		}
+	}
+
+	return diags
+}
+
+func flattenUpdateConfig(ctx context.Context, config *client.UpdateConfigSwarm, priorValue types.Object, diags *diag.Diagnostics) types.Object {
+	if config == nil {
+		return types.ObjectNull(applicationUpdateConfigAttrTypes)
+	}
+	var prior ApplicationUpdateConfigModel
+	diags.Append(priorValue.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+
+	maxFailureRatio := types.Float64Null()
+	if config.MaxFailureRatio != 0 || !prior.MaxFailureRatio.IsNull() {
+		maxFailureRatio = types.Float64Value(config.MaxFailureRatio)
+	}
+
+	value, d := types.ObjectValue(applicationUpdateConfigAttrTypes, map[string]attr.Value{
+		"parallelism":       types.Int64Value(config.Parallelism),
+		"delay":             swarmDurationValue(config.Delay, prior.Delay),
+		"failure_action":    swarmString(config.FailureAction, prior.FailureAction),
+		"monitor":           swarmDurationValue(config.Monitor, prior.Monitor),
+		"max_failure_ratio": maxFailureRatio,
+		"order":             swarmString(config.Order, prior.Order),
+	})
+	diags.Append(d...)
+	return value
+}
+
+func isConfiguredObject(value types.Object) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// int64StringPointer converts a configured number into the string form Dokploy
+// stores resource limits in. Unset values clear the limit.
+func int64StringPointer(value types.Int64) *string {
+	result := ""
+	if !value.IsNull() && !value.IsUnknown() {
+		result = strconv.FormatInt(value.ValueInt64(), 10)
+	}
+	return &result
+}
+
+func int64FromString(value *string) types.Int64 {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return types.Int64Null()
+	}
+	parsed, err := strconv.ParseInt(strings.TrimSpace(*value), 10, 64)
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(parsed)
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// swarmDuration parses a configured Go duration into nanoseconds.
+func swarmDuration(value types.String, attrPath path.Path, diags *diag.Diagnostics) int64 {
+	configured, ok := configuredString(value)
+	if !ok {
+		return 0
+	}
+	duration, err := time.ParseDuration(configured)
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(attrPath, "Invalid Swarm Configuration",
+			fmt.Sprintf("%s must be a duration such as \"30s\", got %q.", attrPath, configured))
+		return 0
+	}
+	return duration.Nanoseconds()
+}
+
+// swarmDurationValue converts nanoseconds from Dokploy into a duration string,
+// keeping the configured spelling when it denotes the same duration.
+func swarmDurationValue(nanoseconds int64, prior types.String) types.String {
+	if configured, ok := configuredString(prior); ok {
+		if duration, err := time.ParseDuration(configured); err == nil && duration.Nanoseconds() == nanoseconds {
+			return prior
+		}
+	}
+	if nanoseconds == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Duration(nanoseconds).String())
+}
+
+func swarmString(value string, prior types.String) types.String {
+	if value == "" && (prior.IsNull() || prior.ValueString() != "") {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func swarmInt64(value int64, prior types.Int64) types.Int64 {
+	if value == 0 && prior.IsNull() {
+		return types.Int64Null()
+	}
+	return types.Int64Value(value)
+}
+
+func swarmList(ctx context.Context, values []string, prior types.List, diags *diag.Diagnostics) types.List {
+	if len(values) == 0 {
+		if !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+			return prior
+		}
+		return types.ListNull(types.StringType)
+	}
+	value, d := types.ListValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return value
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func testApplicationSwarmModel() ApplicationResourceModel {
+	return ApplicationResourceModel{
+		Resources:      types.ObjectNull(applicationResourcesAttrTypes),
+		Replicas:       types.Int64Null(),
+		Mode:           types.StringNull(),
+		Command:        types.StringNull(),
+		Args:           types.ListNull(types.StringType),
+		HealthCheck:    types.ObjectNull(applicationHealthCheckAttrTypes),
+		RestartPolicy:  types.ObjectNull(applicationRestartPolicyAttrTypes),
+		Placement:      types.ObjectNull(applicationPlacementAttrTypes),
+		UpdateConfig:   types.ObjectNull(applicationUpdateConfigAttrTypes),
+		RollbackConfig: types.ObjectNull(applicationUpdateConfigAttrTypes),
+		Networks:       types.ListNull(applicationNetworkObjectType),
+	}
+}
+
+func testHealthCheckObject(t *testing.T, interval string) types.Object {
+	t.Helper()
+	test, diags := types.ListValueFrom(context.Background(), types.StringType, []string{"CMD", "true"})
+	if diags.HasError() {
+		t.Fatalf("building test list: %v", diags)
+	}
+	obj, diags := types.ObjectValue(applicationHealthCheckAttrTypes, map[string]attr.Value{
+		"test":         test,
+		"interval":     types.StringValue(interval),
+		"timeout":      types.StringNull(),
+		"start_period": types.StringNull(),
+		"retries":      types.Int64Value(3),
+	})
+	if diags.HasError() {
+		t.Fatalf("building health_check object: %v", diags)
+	}
+	return obj
+}
+
+func TestExpandApplicationSwarm_ConvertsUnits(t *testing.T) {
+	plan := testApplicationSwarmModel()
+	resources, diags := types.ObjectValue(applicationResourcesAttrTypes, map[string]attr.Value{
+		"memory_reservation": types.Int64Null(),
+		"memory_limit":       types.Int64Value(536870912),
+		"cpu_reservation":    types.Int64Null(),
+		"cpu_limit":          types.Int64Value(1500000000),
+	})
+	if diags.HasError() {
+		t.Fatalf("building resources object: %v", diags)
+	}
+	plan.Resources = resources
+	plan.Replicas = types.Int64Value(3)
+	plan.Mode = types.StringValue("replicated")
+	plan.HealthCheck = testHealthCheckObject(t, "90s")
+
+	swarm, diags := expandApplicationSwarm(context.Background(), plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if *swarm.MemoryLimit != "536870912" || *swarm.CPULimit != "1500000000" || *swarm.MemoryReservation != "" {
+		t.Fatalf("unexpected resources: %+v", swarm)
+	}
+	if swarm.Mode == nil || swarm.Mode.Replicated == nil || swarm.Mode.Replicated.Replicas != 3 {
+		t.Fatalf("unexpected mode: %+v", swarm.Mode)
+	}
+	if swarm.HealthCheck.Interval != 90_000_000_000 || swarm.HealthCheck.Retries != 3 || len(swarm.HealthCheck.Test) != 2 {
+		t.Fatalf("unexpected health check: %+v", swarm.HealthCheck)
+	}
+	if swarm.Placement != nil || swarm.Args != nil {
+		t.Fatalf("unset settings should stay nil: %+v", swarm)
+	}
+}
+
+func TestValidateApplicationSwarm(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*ApplicationResourceModel)
+		errors int
+	}{
+		{"valid", func(config *ApplicationResourceModel) {
+			config.Mode = types.StringValue("Global")
+			config.HealthCheck = testHealthCheckObject(t, "30s")
+		}, 0},
+		{"invalid mode", func(config *ApplicationResourceModel) { config.Mode = types.StringValue("daemonset") }, 1},
+		{"unknown mode", func(config *ApplicationResourceModel) { config.Mode = types.StringUnknown() }, 0},
+		{"invalid duration", func(config *ApplicationResourceModel) { config.HealthCheck = testHealthCheckObject(t, "often") }, 1},
+		{"unknown health check", func(config *ApplicationResourceModel) {
+			config.HealthCheck = types.ObjectUnknown(applicationHealthCheckAttrTypes)
+		}, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := testApplicationSwarmModel()
+			tc.modify(&config)
+			if diags := validateApplicationSwarm(context.Background(), config); diags.ErrorsCount() != tc.errors {
+				t.Fatalf("expected %d errors, got %v", tc.errors, diags)
+			}
+		})
+	}
+}
+
+func TestClearRemovedApplicationSwarm(t *testing.T) {
+	state := testApplicationSwarmModel()
+	state.HealthCheck = testHealthCheckObject(t, "30s")
+	state.Replicas = types.Int64Value(2)
+
+	var swarm client.ApplicationSwarm
+	clearRemovedApplicationSwarm(&swarm, testApplicationSwarmModel(), state)
+
+	if swarm.HealthCheck == nil || len(swarm.HealthCheck.Test) != 0 {
+		t.Fatalf("expected an empty health check to clear it, got %+v", swarm.HealthCheck)
+	}
+	if swarm.Replicas == nil || *swarm.Replicas != 1 {
+		t.Fatalf("expected replicas to reset to 1, got %v", swarm.Replicas)
+	}
+	if swarm.Placement != nil || swarm.Networks != nil {
+		t.Fatalf("settings that were never configured should stay nil: %+v", swarm)
+	}
+}
+
+func TestFlattenApplicationSwarm_KeepsDurationSpelling(t *testing.T) {
+	state := testApplicationSwarmModel()
+	state.HealthCheck = testHealthCheckObject(t, "90s")
+	state.Command = types.StringValue("npm")
+
+	diags := flattenApplicationSwarm(context.Background(), client.ApplicationSwarm{
+		HealthCheck: &client.HealthCheckSwarm{Test: []string{"CMD", "true"}, Interval: 90_000_000_000, Retries: 3},
+		Placement:   &client.PlacementSwarm{Constraints: []string{"node.role==worker"}},
+	}, &state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var healthCheck ApplicationHealthCheckModel
+	if diags := state.HealthCheck.As(context.Background(), &healthCheck, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("decoding health_check: %v", diags)
+	}
+	if healthCheck.Interval.ValueString() != "90s" {
+		t.Fatalf("interval should keep its configured spelling, got %q", healthCheck.Interval.ValueString())
+	}
+	if !state.Command.IsNull() {
+		t.Fatalf("command removed in Dokploy should be null, got %v", state.Command)
+	}
+	if !state.Placement.IsNull() {
+		t.Fatalf("unmanaged placement should stay null, got %v", state.Placement)
+	}
+}
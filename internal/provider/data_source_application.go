@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ datasource.DataSource = &ApplicationDataSource{}
+
+func NewApplicationDataSource() datasource.DataSource {
+	return &ApplicationDataSource{}
+}
+
+type ApplicationDataSource struct {
+	client *client.DokployClient
+}
+
+type ApplicationDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ProjectID     types.String `tfsdk:"project_id"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	Name          types.String `tfsdk:"name"`
+	AppName       types.String `tfsdk:"app_name"`
+	SourceType    types.String `tfsdk:"source_type"`
+	BuildType     types.String `tfsdk:"build_type"`
+	RepositoryURL types.String `tfsdk:"repository_url"`
+	Branch        types.String `tfsdk:"branch"`
+	DockerImage   types.String `tfsdk:"docker_image"`
+	AutoDeploy    types.Bool   `tfsdk:"auto_deploy"`
+}
+
+func (d *ApplicationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application"
+}
+
+func (d *ApplicationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing Dokploy application by ID, or by name within a project environment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Application ID. Exactly one of id or name must be set.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Project ID. Required when looking up by name.",
+			},
+			"environment_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Environment ID. Required when looking up by name.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Application name. Exactly one of id or name must be set.",
+			},
+			"app_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal Dokploy app name used for containers and volumes.",
+			},
+			"source_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"build_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"repository_url": schema.StringAttribute{
+				Computed: true,
+			},
+			"branch": schema.StringAttribute{
+				Computed: true,
+			},
+			"docker_image": schema.StringAttribute{
+				Computed: true,
+			},
+			"auto_deploy": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *ApplicationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ApplicationDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, name, err := dataSourceLookupKey(config.ID, config.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid application lookup", err.Error())
+		return
+	}
+
+	if id == "" {
+		env, err := lookupParentEnvironment(ctx, d.client, config.ProjectID, config.EnvironmentID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up application", err.Error())
+			return
+		}
+		id, err = findApplicationIDByName(env.Applications, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up application", err.Error())
+			return
+		}
+	}
+
+	app, err := d.client.GetApplication(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading application", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(app.ID)
+	config.Name = types.StringValue(app.Name)
+	if app.ProjectID != "" {
+		config.ProjectID = types.StringValue(app.ProjectID)
+	} else if config.ProjectID.IsNull() {
+		config.ProjectID = types.StringValue("")
+	}
+	if app.EnvironmentID != "" {
+		config.EnvironmentID = types.StringValue(app.EnvironmentID)
+	} else if config.EnvironmentID.IsNull() {
+		config.EnvironmentID = types.StringValue("")
+	}
+	config.AppName = types.StringValue(app.AppName)
+	config.SourceType = types.StringValue(app.SourceType)
+	config.BuildType = types.StringValue(app.BuildType)
+	config.RepositoryURL = types.StringValue(app.RepositoryURL)
+	config.Branch = types.StringValue(app.Branch)
+	config.DockerImage = types.StringValue(app.DockerImage)
+	config.AutoDeploy = types.BoolValue(app.AutoDeploy)
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// lookupParentEnvironment loads the environment a name-based data source lookup
+// is scoped to. Environments are only exposed through their parent project.
+func lookupParentEnvironment(ctx context.Context, c *client.DokployClient, projectID, environmentID types.String) (*client.Environment, error) {
+	if projectID.IsNull() || projectID.IsUnknown() || projectID.ValueString() == "" ||
+		environmentID.IsNull() || environmentID.IsUnknown() || environmentID.ValueString() == "" {
+		return nil, fmt.Errorf("project_id and environment_id are required when looking up by name")
+	}
+
+	project, err := c.GetProject(ctx, projectID.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read parent project: %w", err)
+	}
+
+	return findProjectEnvironment(project, environmentID.ValueString(), "")
+}
+
+func findApplicationIDByName(apps []client.Application, name string) (string, error) {
+	var matches []string
+	for _, app := range apps {
+		if app.Name == name || app.AppName == name {
+			matches = append(matches, app.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no application named %q found in environment", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("found %d applications named %q; look the application up by id instead", len(matches), name)
+	}
+}
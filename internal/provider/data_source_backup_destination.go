@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ datasource.DataSource = &BackupDestinationDataSource{}
+
+func NewBackupDestinationDataSource() datasource.DataSource {
+	return &BackupDestinationDataSource{}
+}
+
+type BackupDestinationDataSource struct {
+	client *client.DokployClient
+}
+
+type BackupDestinationDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Bucket   types.String `tfsdk:"bucket"`
+	Region   types.String `tfsdk:"region"`
+	Endpoint types.String `tfsdk:"endpoint"`
+}
+
+func (d *BackupDestinationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_destination"
+}
+
+func (d *BackupDestinationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing Dokploy backup destination by ID or by name. Credentials are never exposed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Destination ID. Exactly one of id or name must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Destination name (case-insensitive). Exactly one of id or name must be set.",
+			},
+			"type": schema.StringAttribute{
+				Computed: true,
+			},
+			"bucket": schema.StringAttribute{
+				Computed: true,
+			},
+			"region": schema.StringAttribute{
+				Computed: true,
+			},
+			"endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *BackupDestinationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *BackupDestinationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config BackupDestinationDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, name, err := dataSourceLookupKey(config.ID, config.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid backup destination lookup", err.Error())
+		return
+	}
+
+	var destination *client.BackupDestination
+	if id != "" {
+		destination, err = d.client.GetBackupDestination(ctx, id)
+	} else {
+		destination, err = d.client.FindBackupDestinationByName(ctx, name)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading backup destination", err.Error())
+		return
+	}
+
+	providerType := strings.TrimSpace(destination.Type)
+	if providerType == "" {
+		providerType = strings.TrimSpace(destination.Provider)
+	}
+
+	config.ID = types.StringValue(destination.ID)
+	config.Name = types.StringValue(destination.Name)
+	config.Type = types.StringValue(providerType)
+	config.Bucket = types.StringValue(destination.Bucket)
+	config.Region = types.StringValue(destination.Region)
+	config.Endpoint = types.StringValue(destination.Endpoint)
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
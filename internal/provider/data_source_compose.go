@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ datasource.DataSource = &ComposeDataSource{}
+
+func NewComposeDataSource() datasource.DataSource {
+	return &ComposeDataSource{}
+}
+
+type ComposeDataSource struct {
+	client *client.DokployClient
+}
+
+type ComposeDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ProjectID     types.String `tfsdk:"project_id"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	Name          types.String `tfsdk:"name"`
+	AppName       types.String `tfsdk:"app_name"`
+	SourceType    types.String `tfsdk:"source_type"`
+	ComposePath   types.String `tfsdk:"compose_path"`
+	AutoDeploy    types.Bool   `tfsdk:"auto_deploy"`
+}
+
+func (d *ComposeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compose"
+}
+
+func (d *ComposeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing Dokploy compose stack by ID, or by name within a project environment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Compose stack ID. Exactly one of id or name must be set.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Project ID. Required when looking up by name.",
+			},
+			"environment_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Environment ID. Required when looking up by name.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Compose stack name. Exactly one of id or name must be set.",
+			},
+			"app_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal Dokploy app name used to prefix volumes and services.",
+			},
+			"source_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"compose_path": schema.StringAttribute{
+				Computed: true,
+			},
+			"auto_deploy": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *ComposeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ComposeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ComposeDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, name, err := dataSourceLookupKey(config.ID, config.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid compose lookup", err.Error())
+		return
+	}
+
+	if id == "" {
+		env, err := lookupParentEnvironment(ctx, d.client, config.ProjectID, config.EnvironmentID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up compose", err.Error())
+			return
+		}
+		id, err = findComposeIDByName(env.Compose, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up compose", err.Error())
+			return
+		}
+	}
+
+	comp, err := d.client.GetCompose(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading compose", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(comp.ID)
+	config.Name = types.StringValue(comp.Name)
+	if comp.ProjectID != "" {
+		config.ProjectID = types.StringValue(comp.ProjectID)
+	} else if config.ProjectID.IsNull() {
+		config.ProjectID = types.StringValue("")
+	}
+	if comp.EnvironmentID != "" {
+		config.EnvironmentID = types.StringValue(comp.EnvironmentID)
+	} else if config.EnvironmentID.IsNull() {
+		config.EnvironmentID = types.StringValue("")
+	}
+	config.AppName = types.StringValue(comp.AppName)
+	config.SourceType = types.StringValue(comp.SourceType)
+	config.ComposePath = types.StringValue(comp.ComposePath)
+	config.AutoDeploy = types.BoolValue(comp.AutoDeploy)
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+func findComposeIDByName(stacks []client.Compose, name string) (string, error) {
+	var matches []string
+	for _, comp := range stacks {
+		if comp.Name == name || comp.AppName == name {
+			matches = append(matches, comp.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no compose stack named %q found in environment", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("found %d compose stacks named %q; look the compose stack up by id instead", len(matches), name)
+	}
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ datasource.DataSource = &DatabaseDataSource{}
+
+func NewDatabaseDataSource() datasource.DataSource {
+	return &DatabaseDataSource{}
+}
+
+type DatabaseDataSource struct {
+	client *client.DokployClient
+}
+
+type DatabaseDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ProjectID     types.String `tfsdk:"project_id"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	Type          types.String `tfsdk:"type"`
+	Name          types.String `tfsdk:"name"`
+	AppName       types.String `tfsdk:"app_name"`
+	DockerImage   types.String `tfsdk:"docker_image"`
+	InternalPort  types.Int64  `tfsdk:"internal_port"`
+	ExternalPort  types.Int64  `tfsdk:"external_port"`
+}
+
+func (d *DatabaseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (d *DatabaseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing Dokploy database by ID, or by name within a project environment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Database ID. Exactly one of id or name must be set.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Project ID. Required when looking up by name.",
+			},
+			"environment_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Environment ID. Required when looking up by name.",
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Database engine: postgres, mysql, mariadb, mongo or redis.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Database name. Exactly one of id or name must be set.",
+			},
+			"app_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"docker_image": schema.StringAttribute{
+				Computed: true,
+			},
+			"internal_port": schema.Int64Attribute{
+				Computed: true,
+			},
+			"external_port": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *DatabaseDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DatabaseDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, name, err := dataSourceLookupKey(config.ID, config.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid database lookup", err.Error())
+		return
+	}
+
+	dbType := config.Type.ValueString()
+	if id == "" {
+		if config.ProjectID.IsNull() || config.ProjectID.ValueString() == "" ||
+			config.EnvironmentID.IsNull() || config.EnvironmentID.ValueString() == "" {
+			resp.Diagnostics.AddError("Invalid database lookup", "project_id and environment_id are required when looking up by name")
+			return
+		}
+		found, err := d.client.FindDatabaseByName(ctx, config.ProjectID.ValueString(), config.EnvironmentID.ValueString(), dbType, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up database", err.Error())
+			return
+		}
+		id = found.ID
+	}
+
+	db, err := d.client.GetDatabase(ctx, id, dbType)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading database", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(db.ID)
+	config.Name = types.StringValue(db.Name)
+	if db.ProjectID != "" {
+		config.ProjectID = types.StringValue(db.ProjectID)
+	} else if config.ProjectID.IsNull() {
+		config.ProjectID = types.StringValue("")
+	}
+	if db.EnvironmentID != "" {
+		config.EnvironmentID = types.StringValue(db.EnvironmentID)
+	} else if config.EnvironmentID.IsNull() {
+		config.EnvironmentID = types.StringValue("")
+	}
+	config.AppName = types.StringValue(db.AppName)
+	config.DockerImage = types.StringValue(db.DockerImage)
+	config.InternalPort = types.Int64Value(db.InternalPort)
+	config.ExternalPort = types.Int64Value(db.ExternalPort)
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
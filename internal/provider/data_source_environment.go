@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ datasource.DataSource = &EnvironmentDataSource{}
+
+func NewEnvironmentDataSource() datasource.DataSource {
+	return &EnvironmentDataSource{}
+}
+
+type EnvironmentDataSource struct {
+	client *client.DokployClient
+}
+
+type EnvironmentDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *EnvironmentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment"
+}
+
+func (d *EnvironmentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing environment of a Dokploy project by ID or by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Environment ID. Exactly one of id or name must be set.",
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the project the environment belongs to.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Environment name. Exactly one of id or name must be set.",
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *EnvironmentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config EnvironmentDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, name, err := dataSourceLookupKey(config.ID, config.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid environment lookup", err.Error())
+		return
+	}
+
+	project, err := d.client.GetProject(ctx, config.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading parent project", err.Error())
+		return
+	}
+
+	env, err := findProjectEnvironment(project, id, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error looking up environment", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(env.ID)
+	config.Name = types.StringValue(env.Name)
+	config.Description = types.StringValue(lowercaseFirstRune(env.Description))
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// findProjectEnvironment resolves an environment of project by ID, or by
+// case-insensitive name when id is empty.
+func findProjectEnvironment(project *client.Project, id, name string) (*client.Environment, error) {
+	for _, env := range project.Environments {
+		if id != "" && env.ID == id {
+			matched := env
+			return &matched, nil
+		}
+		if id == "" && strings.EqualFold(env.Name, name) {
+			matched := env
+			return &matched, nil
+		}
+	}
+
+	if id != "" {
+		return nil, fmt.Errorf("environment %s not found in project %s", id, project.ID)
+	}
+	return nil, fmt.Errorf("no environment named %q found in project %s", name, project.ID)
+}
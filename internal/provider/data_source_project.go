@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ datasource.DataSource = &ProjectDataSource{}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+type ProjectDataSource struct {
+	client *client.DokployClient
+}
+
+type ProjectDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	EnvironmentIDs types.List   `tfsdk:"environment_ids"`
+}
+
+func (d *ProjectDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *ProjectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing Dokploy project by ID or by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Project ID. Exactly one of id or name must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Project name. Exactly one of id or name must be set.",
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"environment_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of the environments that belong to the project.",
+			},
+		},
+	}
+}
+
+func (d *ProjectDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ProjectDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, name, err := dataSourceLookupKey(config.ID, config.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid project lookup", err.Error())
+		return
+	}
+
+	var project *client.Project
+	if id != "" {
+		project, err = d.client.GetProject(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading project", err.Error())
+			return
+		}
+	} else {
+		projects, err := d.client.ListProjects(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing projects", err.Error())
+			return
+		}
+		project, err = findProjectByName(projects, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up project", err.Error())
+			return
+		}
+	}
+
+	environmentIDs := make([]string, 0, len(project.Environments))
+	for _, env := range project.Environments {
+		environmentIDs = append(environmentIDs, env.ID)
+	}
+
+	config.ID = types.StringValue(project.ID)
+	config.Name = types.StringValue(project.Name)
+	config.Description = types.StringValue(project.Description)
+	config.EnvironmentIDs, diags = types.ListValueFrom(ctx, types.StringType, environmentIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// dataSourceLookupKey returns the trimmed ID or name a data source should be
+// resolved by, requiring exactly one of them to be configured.
+func dataSourceLookupKey(id, name types.String) (string, string, error) {
+	hasID := !id.IsNull() && !id.IsUnknown() && strings.TrimSpace(id.ValueString()) != ""
+	hasName := !name.IsNull() && !name.IsUnknown() && strings.TrimSpace(name.ValueString()) != ""
+
+	if hasID && hasName {
+		return "", "", fmt.Errorf("only one of id or name can be provided")
+	}
+	if !hasID && !hasName {
+		return "", "", fmt.Errorf("either id or name must be provided")
+	}
+
+	if hasID {
+		return strings.TrimSpace(id.ValueString()), "", nil
+	}
+	return "", strings.TrimSpace(name.ValueString()), nil
+}
+
+func findProjectByName(projects []client.Project, name string) (*client.Project, error) {
+	var matches []client.Project
+	for _, project := range projects {
+		if project.Name == name {
+			matches = append(matches, project)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no project found with name %q", name)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d projects with name %q; look the project up by id instead", len(matches), name)
+	}
+}
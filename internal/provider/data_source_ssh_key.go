@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ datasource.DataSource = &SSHKeyDataSource{}
+
+func NewSSHKeyDataSource() datasource.DataSource {
+	return &SSHKeyDataSource{}
+}
+
+type SSHKeyDataSource struct {
+	client *client.DokployClient
+}
+
+type SSHKeyDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	PublicKey   types.String `tfsdk:"public_key"`
+}
+
+func (d *SSHKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+func (d *SSHKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing Dokploy SSH key by ID or by name. The private key is never exposed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "SSH key ID. Exactly one of id or name must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "SSH key name. Exactly one of id or name must be set.",
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"public_key": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *SSHKeyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *SSHKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SSHKeyDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, name, err := dataSourceLookupKey(config.ID, config.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid SSH key lookup", err.Error())
+		return
+	}
+
+	keys, err := d.client.ListSSHKeys(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing SSH keys", err.Error())
+		return
+	}
+
+	key, err := findSSHKey(keys, id, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error looking up SSH key", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(key.ID)
+	config.Name = types.StringValue(key.Name)
+	config.Description = types.StringValue(key.Description)
+	config.PublicKey = types.StringValue(key.PublicKey)
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+func findSSHKey(keys []client.SSHKey, id, name string) (*client.SSHKey, error) {
+	var matches []client.SSHKey
+	for _, key := range keys {
+		if (id != "" && key.ID == id) || (id == "" && key.Name == name) {
+			matches = append(matches, key)
+		}
+	}
+
+	switch {
+	case len(matches) == 1:
+		return &matches[0], nil
+	case len(matches) > 1:
+		return nil, fmt.Errorf("found %d SSH keys with name %q; look the key up by id instead", len(matches), name)
+	case id != "":
+		return nil, fmt.Errorf("no SSH key found with id %s", id)
+	default:
+		return nil, fmt.Errorf("no SSH key found with name %q", name)
+	}
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func TestDataSourceLookupKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        types.String
+		lookup    types.String
+		wantID    string
+		wantName  string
+		expectErr bool
+	}{
+		{
+			name:   "id only",
+			id:     types.StringValue(" proj-1 "),
+			lookup: types.StringNull(),
+			wantID: "proj-1",
+		},
+		{
+			name:     "name only",
+			id:       types.StringNull(),
+			lookup:   types.StringValue("shop"),
+			wantName: "shop",
+		},
+		{
+			name:      "both set",
+			id:        types.StringValue("proj-1"),
+			lookup:    types.StringValue("shop"),
+			expectErr: true,
+		},
+		{
+			name:      "neither set",
+			id:        types.StringNull(),
+			lookup:    types.StringValue("  "),
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			id, name, err := dataSourceLookupKey(test.id, test.lookup)
+			if test.expectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if id != test.wantID || name != test.wantName {
+				t.Fatalf("unexpected key: got (%q, %q) want (%q, %q)", id, name, test.wantID, test.wantName)
+			}
+		})
+	}
+}
+
+func TestFindProjectByName(t *testing.T) {
+	projects := []client.Project{
+		{ID: "proj-1", Name: "shop"},
+		{ID: "proj-2", Name: "blog"},
+		{ID: "proj-3", Name: "blog"},
+	}
+
+	project, err := findProjectByName(projects, "shop")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if project.ID != "proj-1" {
+		t.Fatalf("unexpected project: %s", project.ID)
+	}
+
+	if _, err := findProjectByName(projects, "blog"); err == nil {
+		t.Fatal("expected error for ambiguous name")
+	}
+	if _, err := findProjectByName(projects, "missing"); err == nil {
+		t.Fatal("expected error for missing name")
+	}
+}
+
+func TestFindProjectEnvironment(t *testing.T) {
+	project := &client.Project{
+		ID: "proj-1",
+		Environments: []client.Environment{
+			{ID: "env-1", Name: "production"},
+			{ID: "env-2", Name: "staging"},
+		},
+	}
+
+	env, err := findProjectEnvironment(project, "env-2", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if env.Name != "staging" {
+		t.Fatalf("unexpected environment: %s", env.Name)
+	}
+
+	env, err = findProjectEnvironment(project, "", "Production")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if env.ID != "env-1" {
+		t.Fatalf("unexpected environment: %s", env.ID)
+	}
+
+	if _, err := findProjectEnvironment(project, "", "preview"); err == nil {
+		t.Fatal("expected error for missing environment")
+	}
+}
+
+func TestFindApplicationIDByName_MatchesNameOrAppName(t *testing.T) {
+	apps := []client.Application{
+		{ID: "app-1", Name: "web", AppName: "web-x1y2z3"},
+		{ID: "app-2", Name: "worker", AppName: "worker-a1b2c3"},
+	}
+
+	for _, name := range []string{"web", "web-x1y2z3"} {
+		id, err := findApplicationIDByName(apps, name)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", name, err)
+		}
+		if id != "app-1" {
+			t.Fatalf("unexpected id for %q: %s", name, id)
+		}
+	}
+
+	if _, err := findApplicationIDByName(apps, "api"); err == nil {
+		t.Fatal("expected error for missing application")
+	}
+}
+
+func TestFindSSHKey(t *testing.T) {
+	keys := []client.SSHKey{
+		{ID: "key-1", Name: "deploy"},
+		{ID: "key-2", Name: "backup"},
+	}
+
+	key, err := findSSHKey(keys, "", "backup")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key.ID != "key-2" {
+		t.Fatalf("unexpected key: %s", key.ID)
+	}
+
+	key, err = findSSHKey(keys, "key-1", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key.Name != "deploy" {
+		t.Fatalf("unexpected key: %s", key.Name)
+	}
+
+	if _, err := findSSHKey(keys, "key-9", ""); err == nil {
+		t.Fatal("expected error for missing id")
+	}
+}
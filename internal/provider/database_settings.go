@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+// databaseDockerImage returns the official image of a database engine, tagged
+// with version when one is set.
+func databaseDockerImage(dbType, version string) string {
+	if version == "" {
+		return dbType
+	}
+	return fmt.Sprintf("%s:%s", dbType, version)
+}
+
+// databaseDockerImageModifier plans docker_image from type and version when it
+// is not configured, so changing the version updates the image in place.
+type databaseDockerImageModifier struct{}
+
+func (m databaseDockerImageModifier) Description(_ context.Context) string {
+	return "Defaults to the engine's official image tagged with version."
+}
+
+func (m databaseDockerImageModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m databaseDockerImageModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var dbType, version types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &dbType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("version"), &version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if dbType.IsUnknown() || version.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+	resp.PlanValue = types.StringValue(databaseDockerImage(dbType.ValueString(), version.ValueString()))
+}
+
+// defaultDatabaseName returns the database Dokploy creates inside an engine
+// when database_name is not set. Redis has none.
+func defaultDatabaseName(dbType, name string) types.String {
+	if dbType == "redis" {
+		return types.StringNull()
+	}
+	return types.StringValue(name)
+}
+
+// defaultDatabaseUser returns the user Dokploy creates inside an engine when
+// database_user is not set.
+func defaultDatabaseUser(dbType string) types.String {
+	return types.StringValue(client.DefaultDatabaseUser(dbType))
+}
+
+// replaceIfPriorKnown forces a new resource when a setting that is fixed on
+// creation changes, but not when state has no value for it yet, such as
+// after an upgrade from a version that did not track it.
+func replaceIfPriorKnown() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull() && !req.StateValue.IsUnknown()
+		},
+		"Changing the value forces a new resource.",
+		"Changing the value forces a new resource.",
+	)
+}
+
+// expandDatabaseSettings fills the container settings of db from the plan.
+// Settings that were removed from the configuration are cleared; settings
+// that were never managed are left nil so Dokploy keeps them.
+func expandDatabaseSettings(ctx context.Context, db *client.Database, plan, state DatabaseResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	empty := ""
+
+	if value, ok := configuredString(plan.Command); ok {
+		db.Command = &value
+	} else if !state.Command.IsNull() {
+		db.Command = &empty
+	}
+
+	if !plan.Env.IsNull() && !plan.Env.IsUnknown() {
+		values := map[string]string{}
+		diags.Append(plan.Env.ElementsAs(ctx, &values, false)...)
+		env, err := client.FormatEnv(values)
+		if err != nil {
+			diags.AddAttributeError(path.Root("env"), "Invalid Environment Variable", err.Error())
+		}
+		db.Env = &env
+	} else if !state.Env.IsNull() {
+		db.Env = &empty
+	}
+
+	if isConfiguredObject(plan.Resources) {
+		var resources ApplicationResourcesModel
+		diags.Append(plan.Resources.As(ctx, &resources, basetypes.ObjectAsOptions{})...)
+		db.MemoryReservation = int64StringPointer(resources.MemoryReservation)
+		db.MemoryLimit = int64StringPointer(resources.MemoryLimit)
+		db.CPUReservation = int64StringPointer(resources.CPUReservation)
+		db.CPULimit = int64StringPointer(resources.CPULimit)
+	} else if !state.Resources.IsNull() {
+		db.MemoryReservation = &empty
+		db.MemoryLimit = &empty
+		db.CPUReservation = &empty
+		db.CPULimit = &empty
+	}
+
+	return diags
+}
+
+// databaseSettingsConfigured reports whether any container setting needs to
+// be saved after the database is created.
+func databaseSettingsConfigured(plan DatabaseResourceModel) bool {
+	_, hasCommand := configuredString(plan.Command)
+	return hasCommand || !plan.Env.IsNull() || isConfiguredObject(plan.Resources)
+}
+
+// flattenDatabaseSettings refreshes the container settings tracked in state.
+// Like the Swarm settings of applications, unmanaged settings stay null.
+func flattenDatabaseSettings(ctx context.Context, db *client.Database, state *DatabaseResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !state.Command.IsNull() {
+		state.Command = types.StringNull()
+		if command := derefString(db.Command); command != "" {
+			state.Command = types.StringValue(command)
+		}
+	}
+
+	if !state.Env.IsNull() {
+		env, d := types.MapValueFrom(ctx, types.StringType, client.ParseEnv(derefString(db.Env)))
+		diags.Append(d...)
+		state.Env = env
+	}
+
+	if !state.Resources.IsNull() {
+		value, d := types.ObjectValue(applicationResourcesAttrTypes, map[string]attr.Value{
+			"memory_reservation": int64FromString(db.MemoryReservation),
+			"memory_limit":       int64FromString(db.MemoryLimit),
+			"cpu_reservation":    int64FromString(db.CPUReservation),
+			"cpu_limit":          int64FromString(db.CPULimit),
+		})
+		diags.Append(d...)
+		state.Resources = value
+	}
+
+	return diags
+}
+
+// databaseExternalPort maps the external port of a database to state, where
+// an unpublished database has no port.
+func databaseExternalPort(port int64) types.Int64 {
+	if port == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(port)
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func testDatabaseModel() DatabaseResourceModel {
+	return DatabaseResourceModel{
+		Command:   types.StringNull(),
+		Env:       types.MapNull(types.StringType),
+		Resources: types.ObjectNull(applicationResourcesAttrTypes),
+	}
+}
+
+func TestExpandDatabaseSettings(t *testing.T) {
+	ctx := context.Background()
+
+	plan := testDatabaseModel()
+	plan.Env = types.MapValueMust(types.StringType, map[string]attr.Value{"PGDATA": types.StringValue("/data")})
+	plan.Resources = types.ObjectValueMust(applicationResourcesAttrTypes, map[string]attr.Value{
+		"memory_reservation": types.Int64Null(),
+		"memory_limit":       types.Int64Value(1073741824),
+		"cpu_reservation":    types.Int64Null(),
+		"cpu_limit":          types.Int64Null(),
+	})
+	state := testDatabaseModel()
+	state.Command = types.StringValue("postgres -c max_connections=200")
+
+	var db client.Database
+	if diags := expandDatabaseSettings(ctx, &db, plan, state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if db.Command == nil || *db.Command != "" {
+		t.Fatalf("expected the removed command to be cleared, got %v", db.Command)
+	}
+	if db.Env == nil || *db.Env != "PGDATA=/data" {
+		t.Fatalf("unexpected env: %v", db.Env)
+	}
+	if *db.MemoryLimit != "1073741824" || *db.CPULimit != "" {
+		t.Fatalf("unexpected limits: %v %v", *db.MemoryLimit, *db.CPULimit)
+	}
+
+	var untouched client.Database
+	if diags := expandDatabaseSettings(ctx, &untouched, testDatabaseModel(), testDatabaseModel()); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if untouched.Command != nil || untouched.Env != nil || untouched.MemoryLimit != nil {
+		t.Fatalf("expected unmanaged settings to stay nil: %#v", untouched)
+	}
+}
+
+func TestFlattenDatabaseSettings_OnlyManaged(t *testing.T) {
+	command, env := "redis-server --appendonly yes", "A=1"
+	db := &client.Database{Command: &command, Env: &env}
+
+	state := testDatabaseModel()
+	state.Command = types.StringValue("redis-server")
+	if diags := flattenDatabaseSettings(context.Background(), db, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if state.Command.ValueString() != command {
+		t.Fatalf("unexpected command: %s", state.Command)
+	}
+	if !state.Env.IsNull() || !state.Resources.IsNull() {
+		t.Fatalf("expected unmanaged settings to stay null: %s %s", state.Env, state.Resources)
+	}
+}
+
+func TestDatabaseDockerImage(t *testing.T) {
+	if got := databaseDockerImage("postgres", "16"); got != "postgres:16" {
+		t.Fatalf("unexpected image: %s", got)
+	}
+	if got := databaseDockerImage("redis", ""); got != "redis" {
+		t.Fatalf("unexpected image: %s", got)
+	}
+}
+
+func TestDatabaseCreatedStringDefaults(t *testing.T) {
+	if got := databaseCreatedString("", types.StringNull(), defaultDatabaseName("redis", "cache")); !got.IsNull() {
+		t.Fatalf("expected a null database name for redis, got %v", got)
+	}
+	if got := databaseCreatedString("", types.StringNull(), defaultDatabaseName("postgres", "app")); got.ValueString() != "app" {
+		t.Fatalf("expected the name as database name, got %v", got)
+	}
+	if got := databaseCreatedString("", types.StringUnknown(), defaultDatabaseUser("redis")); got.ValueString() != "default" {
+		t.Fatalf("expected the default redis user, got %v", got)
+	}
+	if got := databaseCreatedString("shop", types.StringNull(), defaultDatabaseName("mysql", "app")); got.ValueString() != "shop" {
+		t.Fatalf("expected the reported database name, got %v", got)
+	}
+}
+
+func TestReplaceIfPriorKnown(t *testing.T) {
+	tests := []struct {
+		name  string
+		state types.String
+		want  bool
+	}{
+		{"known prior value", types.StringValue("app"), true},
+		{"null prior value", types.StringNull(), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				ConfigValue: types.StringValue("other"),
+				PlanValue:   types.StringValue("other"),
+				StateValue:  tc.state,
+				State:       tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})},
+				Plan:        tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})},
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+			replaceIfPriorKnown().PlanModifyString(context.Background(), req, resp)
+			if resp.RequiresReplace != tc.want {
+				t.Fatalf("expected RequiresReplace %v, got %v", tc.want, resp.RequiresReplace)
+			}
+		})
+	}
+}
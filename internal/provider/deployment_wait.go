@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+const (
+	defaultWaitForDeploymentTimeout      = 20 * time.Minute
+	defaultWaitForDeploymentPollInterval = 10 * time.Second
+)
+
+// WaitForDeploymentModel is the wait_for_deployment block shared by
+// applications and compose stacks.
+type WaitForDeploymentModel struct {
+	Timeout      types.String `tfsdk:"timeout"`
+	PollInterval types.String `tfsdk:"poll_interval"`
+	FailOnError  types.Bool   `tfsdk:"fail_on_error"`
+}
+
+// deploymentWait holds the parsed wait_for_deployment settings.
+type deploymentWait struct {
+	Timeout      time.Duration
+	PollInterval time.Duration
+	FailOnError  bool
+}
+
+func waitForDeploymentBlock(serviceName string) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: fmt.Sprintf("When set, create and update block until the latest deployment of the %s has finished "+
+			"and Dokploy reports the %s as done (its containers are running).", serviceName, serviceName),
+		Attributes: map[string]schema.Attribute{
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait, as a Go duration such as \"20m\". Defaults to 20m.",
+			},
+			"poll_interval": schema.StringAttribute{
+				Optional:    true,
+				Description: "How often to poll Dokploy, as a Go duration such as \"10s\". Defaults to 10s.",
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether a failed deployment is reported as an error, which taints the resource. When false it is reported as a warning. Defaults to true.",
+			},
+		},
+	}
+}
+
+// expandWaitForDeployment parses the wait_for_deployment block. It returns nil
+// when the block is absent.
+func expandWaitForDeployment(ctx context.Context, block types.Object) (*deploymentWait, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if block.IsNull() || block.IsUnknown() {
+		return nil, diags
+	}
+
+	var model WaitForDeploymentModel
+	diags.Append(block.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	wait := &deploymentWait{
+		Timeout:      defaultWaitForDeploymentTimeout,
+		PollInterval: defaultWaitForDeploymentPollInterval,
+		FailOnError:  true,
+	}
+
+	if value, ok := configuredString(model.Timeout); ok {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			diags.AddAttributeError(path.Root("wait_for_deployment").AtName("timeout"), "Invalid Wait Configuration",
+				fmt.Sprintf("timeout must be a positive duration such as \"20m\", got %q.", value))
+		} else {
+			wait.Timeout = timeout
+		}
+	}
+	if value, ok := configuredString(model.PollInterval); ok {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			diags.AddAttributeError(path.Root("wait_for_deployment").AtName("poll_interval"), "Invalid Wait Configuration",
+				fmt.Sprintf("poll_interval must be a positive duration such as \"10s\", got %q.", value))
+		} else {
+			wait.PollInterval = interval
+		}
+	}
+	if !model.FailOnError.IsNull() && !model.FailOnError.IsUnknown() {
+		wait.FailOnError = model.FailOnError.ValueBool()
+	}
+
+	return wait, diags
+}
+
+// awaitDeployment blocks until the service's deployment has finished and the
+// service reports done. With a non-nil known set it waits for a deployment
+// that is not in the set to appear; otherwise it waits on the most recent
+// deployment, returning immediately for services that were never deployed.
+func (w *deploymentWait) awaitDeployment(ctx context.Context, c *client.DokployClient, serviceType, serviceID string, known map[string]bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	waitCtx, cancel := context.WithTimeout(ctx, w.Timeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Waiting for deployment", map[string]interface{}{
+		"service_type":  serviceType,
+		"service_id":    serviceID,
+		"timeout":       w.Timeout.String(),
+		"poll_interval": w.PollInterval.String(),
+	})
+
+	var deployment *client.Deployment
+	var err error
+	if known != nil {
+		deployment, err = c.WaitForNewDeployment(waitCtx, serviceType, serviceID, known, w.PollInterval)
+	} else {
+		deployment, err = c.WaitForLatestDeployment(waitCtx, serviceType, serviceID, w.PollInterval)
+	}
+	if err != nil {
+		diags.AddError("Error waiting for "+serviceType+" deployment", w.waitErrorDetail(ctx, err))
+		return diags
+	}
+	if deployment == nil {
+		return diags
+	}
+
+	if deployment.Status != client.DeploymentStatusDone {
+		w.report(&diags, "Deployment failed", deploymentFailureDetail(ctx, c, serviceType, serviceID, deployment))
+		return diags
+	}
+
+	status, err := c.WaitForServiceStatus(waitCtx, serviceType, serviceID, w.PollInterval)
+	if err != nil {
+		diags.AddError("Error waiting for "+serviceType+" status", w.waitErrorDetail(ctx, err))
+		return diags
+	}
+	if status != client.ServiceStatusDone {
+		w.report(&diags, "Service not running",
+			fmt.Sprintf("Deployment %s of %s %s finished, but Dokploy reports the %s status as %q.", deployment.ID, serviceType, serviceID, serviceType, status))
+	}
+
+	return diags
+}
+
+func (w *deploymentWait) report(diags *diag.Diagnostics, summary, detail string) {
+	if w.FailOnError {
+		diags.AddError(summary, detail)
+		return
+	}
+	diags.AddWarning(summary, detail)
+}
+
+// waitErrorDetail turns an expired deadline into a message that names the
+// setting to raise, leaving cancellation by Terraform and API errors untouched.
+// ctx is the operation context, which is bounded by the timeouts block.
+func (w *deploymentWait) waitErrorDetail(ctx context.Context, err error) string {
+	if !errors.Is(err, context.DeadlineExceeded) {
+		return err.Error()
+	}
+	if ctx.Err() == nil {
+		return fmt.Sprintf("The deployment did not finish within the wait_for_deployment timeout of %s: %s", w.Timeout, err)
+	}
+	return fmt.Sprintf("The deployment did not finish before the operation timed out; raise the value in the timeouts block: %s", err)
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func testWaitForDeploymentObject(t *testing.T, timeout, pollInterval types.String, failOnError types.Bool) types.Object {
+	t.Helper()
+	obj, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"timeout":       types.StringType,
+			"poll_interval": types.StringType,
+			"fail_on_error": types.BoolType,
+		},
+		map[string]attr.Value{
+			"timeout":       timeout,
+			"poll_interval": pollInterval,
+			"fail_on_error": failOnError,
+		},
+	)
+	if diags.HasError() {
+		t.Fatalf("building wait_for_deployment object: %v", diags)
+	}
+	return obj
+}
+
+func TestExpandWaitForDeployment_NullBlock(t *testing.T) {
+	wait, diags := expandWaitForDeployment(context.Background(), types.ObjectNull(nil))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if wait != nil {
+		t.Fatalf("expected no wait settings, got %+v", wait)
+	}
+}
+
+func TestExpandWaitForDeployment_Defaults(t *testing.T) {
+	block := testWaitForDeploymentObject(t, types.StringNull(), types.StringNull(), types.BoolNull())
+	wait, diags := expandWaitForDeployment(context.Background(), block)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if wait.Timeout != defaultWaitForDeploymentTimeout || wait.PollInterval != defaultWaitForDeploymentPollInterval || !wait.FailOnError {
+		t.Fatalf("unexpected defaults: %+v", wait)
+	}
+}
+
+func TestExpandWaitForDeployment_ParsesValues(t *testing.T) {
+	block := testWaitForDeploymentObject(t, types.StringValue("5m"), types.StringValue("2s"), types.BoolValue(false))
+	wait, diags := expandWaitForDeployment(context.Background(), block)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if wait.Timeout != 5*time.Minute || wait.PollInterval != 2*time.Second || wait.FailOnError {
+		t.Fatalf("unexpected settings: %+v", wait)
+	}
+}
+
+func TestExpandWaitForDeployment_RejectsInvalidDurations(t *testing.T) {
+	block := testWaitForDeploymentObject(t, types.StringValue("soon"), types.StringValue("-1s"), types.BoolNull())
+	_, diags := expandWaitForDeployment(context.Background(), block)
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("expected 2 errors, got %v", diags)
+	}
+}
+
+func TestAwaitDeployment_FailOnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[{"deploymentId":"dep-1","status":"error","errorMessage":"build failed","createdAt":"2024-05-01T10:00:00.000Z"}]`))
+	}))
+	defer server.Close()
+
+	c := client.NewDokployClient(server.URL, "test-key")
+
+	wait := &deploymentWait{Timeout: time.Second, PollInterval: time.Millisecond, FailOnError: true}
+	diags := wait.awaitDeployment(context.Background(), c, "application", "app-1", map[string]bool{})
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected an error, got %v", diags)
+	}
+
+	wait.FailOnError = false
+	diags = wait.awaitDeployment(context.Background(), c, "application", "app-1", map[string]bool{})
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+}
+
+func TestAwaitDeployment_WaitsForServiceStatus(t *testing.T) {
+	statusPolls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/deployment.allByCompose":
+			_, _ = w.Write([]byte(`[{"deploymentId":"dep-1","status":"done","createdAt":"2024-05-01T10:00:00.000Z"}]`))
+		case "/compose.one":
+			statusPolls++
+			if statusPolls < 2 {
+				_, _ = w.Write([]byte(`{"composeId":"comp-1","composeStatus":"running"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"composeId":"comp-1","composeStatus":"done"}`))
+		default:
+			t.Errorf("unexpected endpoint: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewDokployClient(server.URL, "test-key")
+	wait := &deploymentWait{Timeout: time.Second, PollInterval: time.Millisecond, FailOnError: true}
+	diags := wait.awaitDeployment(context.Background(), c, "compose", "comp-1", nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if statusPolls != 2 {
+		t.Fatalf("expected 2 status polls, got %d", statusPolls)
+	}
+}
+
+func TestAwaitDeployment_TimesOut(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[{"deploymentId":"dep-1","status":"running","createdAt":"2024-05-01T10:00:00.000Z"}]`))
+	}))
+	defer server.Close()
+
+	c := client.NewDokployClient(server.URL, "test-key")
+	wait := &deploymentWait{Timeout: 20 * time.Millisecond, PollInterval: time.Millisecond, FailOnError: false}
+	diags := wait.awaitDeployment(context.Background(), c, "application", "app-1", nil)
+	// Timeouts are errors even when fail_on_error is false.
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected a timeout error, got %v", diags)
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+const (
+	desiredStateRunning = "running"
+	desiredStateStopped = "stopped"
+)
+
+func desiredStateAttribute(serviceName string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Description: fmt.Sprintf("Whether the %s should be running or stopped. Changing it starts or stops the %s, "+
+			"and a %s stopped or started outside Terraform shows up as drift. When unset, the run state is not managed.",
+			serviceName, serviceName, serviceName),
+	}
+}
+
+func validateDesiredState(value types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+	switch value.ValueString() {
+	case desiredStateRunning, desiredStateStopped:
+	default:
+		diags.AddAttributeError(path.Root("desired_state"), "Invalid Desired State",
+			fmt.Sprintf("desired_state must be %s or %s, got %q.", desiredStateRunning, desiredStateStopped, value.ValueString()))
+	}
+	return diags
+}
+
+// observedDesiredState maps a Dokploy service status to a desired_state.
+// Deploying, deployed and failed services count as running; only idle
+// services, which were stopped or never deployed, count as stopped.
+func observedDesiredState(status string) string {
+	if status == client.ServiceStatusIdle || status == "" {
+		return desiredStateStopped
+	}
+	return desiredStateRunning
+}
+
+// flattenDesiredState refreshes desired_state when it is managed.
+func flattenDesiredState(status string, state types.String) types.String {
+	if state.IsNull() {
+		return state
+	}
+	return types.StringValue(observedDesiredState(status))
+}
+
+// applyDesiredState starts or stops a service so it matches desired, based on
+// the status Dokploy reports. An unset desired state leaves the service alone.
+func applyDesiredState(ctx context.Context, c *client.DokployClient, serviceType, serviceID string, desired types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if desired.IsNull() || desired.IsUnknown() {
+		return diags
+	}
+
+	status, err := c.GetServiceStatus(ctx, serviceType, serviceID)
+	if err != nil {
+		diags.AddError("Error reading "+serviceType+" status", err.Error())
+		return diags
+	}
+	if observedDesiredState(status) == desired.ValueString() {
+		return diags
+	}
+
+	if desired.ValueString() == desiredStateStopped {
+		err = c.StopService(ctx, serviceType, serviceID)
+	} else {
+		err = c.StartService(ctx, serviceType, serviceID)
+	}
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error changing %s to %s", serviceType, desired.ValueString()), err.Error())
+	}
+	return diags
+}
+
+// isDesiredState reports whether desired is set to state.
+func isDesiredState(desired types.String, state string) bool {
+	return !desired.IsNull() && !desired.IsUnknown() && desired.ValueString() == state
+}
+
+// validateDesiredStateDeploy rejects deploying a service on creation that is
+// meant to stay stopped.
+func validateDesiredStateDeploy(desired types.String, deployOnCreate types.Bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if isDesiredState(desired, desiredStateStopped) && !deployOnCreate.IsNull() && !deployOnCreate.IsUnknown() && deployOnCreate.ValueBool() {
+		diags.AddAttributeError(path.Root("deploy_on_create"), "Invalid Desired State",
+			"deploy_on_create cannot be true when desired_state is stopped.")
+	}
+	return diags
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestObservedDesiredState(t *testing.T) {
+	cases := map[string]string{
+		"idle":    desiredStateStopped,
+		"":        desiredStateStopped,
+		"running": desiredStateRunning,
+		"done":    desiredStateRunning,
+		"error":   desiredStateRunning,
+	}
+	for status, want := range cases {
+		if got := observedDesiredState(status); got != want {
+			t.Fatalf("status %q: expected %s, got %s", status, want, got)
+		}
+	}
+}
+
+func TestFlattenDesiredState_OnlyManaged(t *testing.T) {
+	if got := flattenDesiredState("idle", types.StringNull()); !got.IsNull() {
+		t.Fatalf("expected an unmanaged desired_state to stay null, got %s", got)
+	}
+	if got := flattenDesiredState("idle", types.StringValue(desiredStateRunning)); got.ValueString() != desiredStateStopped {
+		t.Fatalf("expected drift to stopped, got %s", got)
+	}
+}
+
+func TestValidateDesiredState(t *testing.T) {
+	if diags := validateDesiredState(types.StringValue("paused")); !diags.HasError() {
+		t.Fatal("expected an error for an unknown state")
+	}
+	if diags := validateDesiredState(types.StringValue(desiredStateStopped)); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if diags := validateDesiredStateDeploy(types.StringValue(desiredStateStopped), types.BoolValue(true)); !diags.HasError() {
+		t.Fatal("expected an error when deploying a stopped service on creation")
+	}
+	if diags := validateDesiredStateDeploy(types.StringValue(desiredStateRunning), types.BoolValue(true)); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
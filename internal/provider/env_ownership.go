@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ownedEnvKeysPrivateKey is the private state key holding the environment
+// variable keys a resource has written.
+const ownedEnvKeysPrivateKey = "owned_env_keys"
+
+const authoritativeEnvDescription = "If true (the default), the resource owns every variable: keys that are not in variables are removed and shown as drift in the plan. If false, only the keys this resource wrote are tracked and removed, and keys added in the Dokploy UI or by other configurations are left alone."
+
+// privateStateGetter and privateStateSetter are implemented by the Private
+// fields of the resource requests and responses.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// readOwnedEnvKeys returns the keys recorded in private state. It returns nil
+// when nothing was recorded, e.g. after an import or for state written by an
+// older provider version.
+func readOwnedEnvKeys(ctx context.Context, private privateStateGetter) ([]string, diag.Diagnostics) {
+	raw, diags := private.GetKey(ctx, ownedEnvKeysPrivateKey)
+	if diags.HasError() || len(raw) == 0 {
+		return nil, diags
+	}
+
+	var keys []string
+	if err := json.Unmarshal(raw, &keys); err != nil {
+		diags.AddError("Error reading private state", "Could not decode the owned environment variable keys: "+err.Error())
+		return nil, diags
+	}
+	return keys, diags
+}
+
+func writeOwnedEnvKeys(ctx context.Context, private privateStateSetter, keys []string) diag.Diagnostics {
+	if keys == nil {
+		keys = []string{}
+	}
+	sort.Strings(keys)
+	raw, err := json.Marshal(keys)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error writing private state", "Could not encode the owned environment variable keys: "+err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, ownedEnvKeysPrivateKey, raw)
+}
+
+// ownedEnvKeysFallback returns the keys a resource owns when private state
+// has no record: the keys in state, or nil to adopt every key on import.
+func ownedEnvKeysFallback(ctx context.Context, variables types.Map) ([]string, diag.Diagnostics) {
+	if variables.IsNull() || variables.IsUnknown() {
+		return nil, nil
+	}
+	values := map[string]string{}
+	diags := variables.ElementsAs(ctx, &values, false)
+	return envKeys(values), diags
+}
+
+// desiredEnvVariables returns the variables to write: variables, or
+// variables_wo when that is configured instead.
+func desiredEnvVariables(ctx context.Context, config tfsdk.Config, variables types.Map) (map[string]string, diag.Diagnostics) {
+	var writeOnly types.Map
+	diags := config.GetAttribute(ctx, path.Root("variables_wo"), &writeOnly)
+	if !writeOnly.IsNull() && !writeOnly.IsUnknown() {
+		variables = writeOnly
+	}
+
+	values := map[string]string{}
+	if !variables.IsNull() && !variables.IsUnknown() {
+		diags.Append(variables.ElementsAs(ctx, &values, false)...)
+	}
+	return values, diags
+}
+
+// envVariablesWriteOnly reports whether the variables of a resource are set
+// with variables_wo and must be kept out of state. Imported resources have no
+// authoritative value yet and adopt the variables instead.
+func envVariablesWriteOnly(variables types.Map, authoritative types.Bool) bool {
+	return variables.IsNull() && !authoritative.IsNull()
+}
+
+// applyEnvVariables returns the update function that writes desired to an
+// env. Authoritative resources remove every other key; otherwise only owned
+// keys that are no longer desired are removed and other keys are left alone.
+func applyEnvVariables(desired map[string]string, owned []string, authoritative bool) func(map[string]string) {
+	return func(m map[string]string) {
+		if authoritative {
+			for k := range m {
+				delete(m, k)
+			}
+		}
+		for _, k := range owned {
+			if _, ok := desired[k]; !ok {
+				delete(m, k)
+			}
+		}
+		for k, v := range desired {
+			m[k] = v
+		}
+	}
+}
+
+// removeEnvVariables returns the update function that removes the variables of
+// a destroyed resource.
+func removeEnvVariables(owned []string, authoritative bool) func(map[string]string) {
+	return applyEnvVariables(map[string]string{}, owned, authoritative)
+}
+
+// managedEnvVariables returns the variables a resource reports in state.
+// Authoritative resources report every key so foreign keys show up as drift;
+// otherwise only owned keys are reported. A nil owned list reports every key.
+func managedEnvVariables(env map[string]string, owned []string, authoritative bool) map[string]string {
+	if authoritative || owned == nil {
+		return env
+	}
+	managed := make(map[string]string, len(owned))
+	for _, k := range owned {
+		if v, ok := env[k]; ok {
+			managed[k] = v
+		}
+	}
+	return managed
+}
+
+func envKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestOwnedEnvKeys_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+
+	owned, diags := readOwnedEnvKeys(ctx, private)
+	if diags.HasError() || owned != nil {
+		t.Fatalf("expected no keys before anything was written, got %v (%v)", owned, diags)
+	}
+
+	if diags := writeOwnedEnvKeys(ctx, private, []string{"B", "A"}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	owned, diags = readOwnedEnvKeys(ctx, private)
+	if diags.HasError() || !reflect.DeepEqual(owned, []string{"A", "B"}) {
+		t.Fatalf("unexpected keys: %v (%v)", owned, diags)
+	}
+
+	if diags := writeOwnedEnvKeys(ctx, private, nil); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	owned, _ = readOwnedEnvKeys(ctx, private)
+	if owned == nil || len(owned) != 0 {
+		t.Fatalf("expected an empty, recorded key list, got %#v", owned)
+	}
+}
+
+func TestApplyEnvVariables(t *testing.T) {
+	current := func() map[string]string {
+		return map[string]string{"OWNED": "1", "DROPPED": "2", "FOREIGN": "3"}
+	}
+	desired := map[string]string{"OWNED": "10", "NEW": "4"}
+	owned := []string{"OWNED", "DROPPED"}
+
+	env := current()
+	applyEnvVariables(desired, owned, false)(env)
+	if want := map[string]string{"OWNED": "10", "NEW": "4", "FOREIGN": "3"}; !reflect.DeepEqual(env, want) {
+		t.Fatalf("non-authoritative update = %v, want %v", env, want)
+	}
+
+	env = current()
+	applyEnvVariables(desired, owned, true)(env)
+	if !reflect.DeepEqual(env, desired) {
+		t.Fatalf("authoritative update = %v, want %v", env, desired)
+	}
+
+	env = current()
+	removeEnvVariables(owned, false)(env)
+	if want := map[string]string{"FOREIGN": "3"}; !reflect.DeepEqual(env, want) {
+		t.Fatalf("non-authoritative delete = %v, want %v", env, want)
+	}
+}
+
+func TestManagedEnvVariables(t *testing.T) {
+	env := map[string]string{"OWNED": "1", "FOREIGN": "3"}
+
+	if got := managedEnvVariables(env, []string{"OWNED", "MISSING"}, false); !reflect.DeepEqual(got, map[string]string{"OWNED": "1"}) {
+		t.Fatalf("non-authoritative read = %v", got)
+	}
+	if got := managedEnvVariables(env, []string{"OWNED"}, true); !reflect.DeepEqual(got, env) {
+		t.Fatalf("authoritative read = %v, want every key", got)
+	}
+	if got := managedEnvVariables(env, nil, false); !reflect.DeepEqual(got, env) {
+		t.Fatalf("read without recorded keys = %v, want every key", got)
+	}
+}
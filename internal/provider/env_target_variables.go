@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+// envTarget describes a Dokploy object whose env holds variables shared by
+// several services, such as a project or an environment. The resources for
+// these targets only differ in the envTarget they are built from.
+type envTarget struct {
+	// typeName is appended to the provider type name.
+	typeName    string
+	description string
+	// idAttribute names the attribute holding the ID of the target.
+	idAttribute string
+	// targetName and variablesName are used in diagnostics.
+	targetName    string
+	variablesName string
+
+	// readEnv and updateEnv take the client first so that client methods
+	// such as (*client.DokployClient).UpdateProjectEnv can be used directly.
+	readEnv   func(c *client.DokployClient, ctx context.Context, id string) (string, error)
+	updateEnv func(c *client.DokployClient, ctx context.Context, id string, updateFn func(envMap map[string]string)) error
+}
+
+// envTargetVariablesModel holds the attributes shared by all env target
+// resources. Resource models embed it next to their ID attribute.
+type envTargetVariablesModel struct {
+	ID            types.String `tfsdk:"id"`
+	Variables     types.Map    `tfsdk:"variables"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+
+	VariablesWO        types.Map   `tfsdk:"variables_wo"`
+	VariablesWOVersion types.Int64 `tfsdk:"variables_wo_version"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// envTargetModel is implemented by pointers to the resource models.
+type envTargetModel[M any] interface {
+	*M
+	targetID() types.String
+	variables() *envTargetVariablesModel
+}
+
+// envTargetVariablesResource manages all variables of an env target as a
+// single resource.
+type envTargetVariablesResource[M any, P envTargetModel[M]] struct {
+	client *client.DokployClient
+	target envTarget
+}
+
+func (r *envTargetVariablesResource[M, P]) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.target.typeName
+}
+
+func (r *envTargetVariablesResource[M, P]) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: r.target.description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			r.target.idAttribute: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"variables": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Sensitive:   true,
+				Description: "Variables to set. Exactly one of variables and variables_wo must be set.",
+			},
+			"variables_wo":         writeOnlyMapAttribute("variables"),
+			"variables_wo_version": writeOnlyVersionAttribute("variables"),
+			"authoritative": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: authoritativeEnvDescription,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *envTargetVariablesResource[M, P]) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *envTargetVariablesResource[M, P]) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model M
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := P(&model).variables()
+	resp.Diagnostics.Append(validateWriteOnlyPair(config.Variables, config.VariablesWO, config.VariablesWOVersion, "variables", true)...)
+}
+
+func (r *envTargetVariablesResource[M, P]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan := P(&model).variables()
+	targetID := P(&model).targetID().ValueString()
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	envMap, diags := desiredEnvVariables(ctx, req.Config, plan.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.target.updateEnv(r.client, ctx, targetID, applyEnvVariables(envMap, nil, plan.Authoritative.ValueBool()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating "+r.target.variablesName, err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(targetID)
+	resp.Diagnostics.Append(writeOwnedEnvKeys(ctx, resp.Private, envKeys(envMap))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *envTargetVariablesResource[M, P]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model M
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := P(&model).variables()
+	targetID := P(&model).targetID().ValueString()
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	env, err := r.target.readEnv(r.client, ctx, targetID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading "+r.target.targetName, err.Error())
+		return
+	}
+
+	envMap := client.ParseEnv(env)
+	writeOnly := envVariablesWriteOnly(state.Variables, state.Authoritative)
+	if state.Authoritative.IsNull() {
+		state.Authoritative = types.BoolValue(true)
+	}
+	owned, diags := readOwnedEnvKeys(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if owned == nil {
+		owned, diags = ownedEnvKeysFallback(ctx, state.Variables)
+		resp.Diagnostics.Append(diags...)
+		if owned == nil {
+			owned = envKeys(envMap)
+		}
+		resp.Diagnostics.Append(writeOwnedEnvKeys(ctx, resp.Private, owned)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(targetID)
+	if !writeOnly {
+		state.Variables, diags = types.MapValueFrom(ctx, types.StringType, managedEnvVariables(envMap, owned, state.Authoritative.ValueBool()))
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *envTargetVariablesResource[M, P]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var planModel, stateModel M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan, state := P(&planModel).variables(), P(&stateModel).variables()
+	targetID := P(&planModel).targetID().ValueString()
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	envMap, diags := desiredEnvVariables(ctx, req.Config, plan.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owned, diags := readOwnedEnvKeys(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if owned == nil {
+		owned, diags = ownedEnvKeysFallback(ctx, state.Variables)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.target.updateEnv(r.client, ctx, targetID, applyEnvVariables(envMap, owned, plan.Authoritative.ValueBool()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating "+r.target.variablesName, err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(targetID)
+	resp.Diagnostics.Append(writeOwnedEnvKeys(ctx, resp.Private, envKeys(envMap))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planModel)...)
+}
+
+func (r *envTargetVariablesResource[M, P]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model M
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := P(&model).variables()
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	owned, diags := readOwnedEnvKeys(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if owned == nil {
+		owned, diags = ownedEnvKeysFallback(ctx, state.Variables)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.target.updateEnv(r.client, ctx, P(&model).targetID().ValueString(), removeEnvVariables(owned, state.Authoritative.IsNull() || state.Authoritative.ValueBool()))
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting "+r.target.variablesName, err.Error())
+		return
+	}
+}
+
+func (r *envTargetVariablesResource[M, P]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID := strings.TrimSpace(req.ID)
+	if importID == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", "Import ID cannot be empty.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.target.idAttribute), importID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
+}
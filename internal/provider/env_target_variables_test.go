@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func TestEnvTargets(t *testing.T) {
+	tests := []struct {
+		target   envTarget
+		resource resource.Resource
+		endpoint string
+		body     string
+	}{
+		{projectEnvTarget, NewProjectEnvironmentVariablesResource(), "/project.one", `{"projectId":"target-1","env":"A=1"}`},
+		{environmentEnvTarget, NewEnvironmentEnvironmentVariablesResource(), "/environment.one", `{"environmentId":"target-1","env":"A=1"}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.target.idAttribute, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tc.endpoint {
+					t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+				}
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			env, err := tc.target.readEnv(client.NewDokployClient(server.URL, "test-key"), ctx, "target-1")
+			if err != nil || env != "A=1" {
+				t.Fatalf("unexpected env %q (%v)", env, err)
+			}
+
+			resp := &resource.ImportStateResponse{State: testResourceState(t, tc.resource, nil)}
+			tc.resource.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: " target-1 "}, resp)
+			var targetID types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root(tc.target.idAttribute), &targetID)...)
+			if resp.Diagnostics.HasError() || targetID.ValueString() != "target-1" {
+				t.Fatalf("unexpected import: %s=%v %v", tc.target.idAttribute, targetID, resp.Diagnostics)
+			}
+		})
+	}
+}
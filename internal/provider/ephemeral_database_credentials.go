@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ ephemeral.EphemeralResource = &DatabaseCredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &DatabaseCredentialsEphemeralResource{}
+
+func NewDatabaseCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &DatabaseCredentialsEphemeralResource{}
+}
+
+type DatabaseCredentialsEphemeralResource struct {
+	client *client.DokployClient
+}
+
+type DatabaseCredentialsEphemeralResourceModel struct {
+	DatabaseID       types.String `tfsdk:"database_id"`
+	Type             types.String `tfsdk:"type"`
+	Host             types.String `tfsdk:"host"`
+	Port             types.Int64  `tfsdk:"port"`
+	ExternalPort     types.Int64  `tfsdk:"external_port"`
+	Username         types.String `tfsdk:"username"`
+	Password         types.String `tfsdk:"password"`
+	DatabaseName     types.String `tfsdk:"database_name"`
+	ConnectionString types.String `tfsdk:"connection_string"`
+}
+
+// databaseDefaultPorts are the ports the engines listen on inside the
+// Dokploy network.
+var databaseDefaultPorts = map[string]int64{
+	"postgres": 5432,
+	"mysql":    3306,
+	"mariadb":  3306,
+	"mongo":    27017,
+	"redis":    6379,
+}
+
+func (r *DatabaseCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_credentials"
+}
+
+func (r *DatabaseCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the connection credentials of a Dokploy database without storing them in plan or state. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Database ID.",
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Database engine: postgres, mysql, mariadb, mongo or redis.",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "Host name of the database inside the Dokploy network (its app name).",
+			},
+			"port": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Port of the database inside the Dokploy network.",
+			},
+			"external_port": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Port the database is published on, or null when it is not exposed.",
+			},
+			"username": schema.StringAttribute{
+				Computed: true,
+			},
+			"password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"database_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Database created inside the engine. Null for redis.",
+			},
+			"connection_string": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Connection URL for clients inside the Dokploy network.",
+			},
+		},
+	}
+}
+
+func (r *DatabaseCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *DatabaseCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data DatabaseCredentialsEphemeralResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	db, err := r.client.GetDatabase(ctx, data.DatabaseID.ValueString(), data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading database", err.Error())
+		return
+	}
+
+	flattenDatabaseCredentials(&data, db)
+
+	diags = resp.Result.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// flattenDatabaseCredentials fills the computed attributes of data from db.
+func flattenDatabaseCredentials(data *DatabaseCredentialsEphemeralResourceModel, db *client.Database) {
+	dbType := data.Type.ValueString()
+
+	username := db.DatabaseUser
+	if username == "" {
+		username = client.DefaultDatabaseUser(dbType)
+	}
+	password := db.DatabasePassword
+	if password == "" {
+		password = db.Password
+	}
+	port := db.InternalPort
+	if port == 0 {
+		port = databaseDefaultPorts[dbType]
+	}
+
+	data.Host = types.StringValue(db.AppName)
+	data.Port = types.Int64Value(port)
+	data.ExternalPort = types.Int64Null()
+	if db.ExternalPort != 0 {
+		data.ExternalPort = types.Int64Value(db.ExternalPort)
+	}
+	data.Username = types.StringValue(username)
+	data.Password = types.StringValue(password)
+	data.DatabaseName = types.StringNull()
+	if db.DatabaseName != "" && dbType != "redis" {
+		data.DatabaseName = types.StringValue(db.DatabaseName)
+	}
+	data.ConnectionString = types.StringValue(databaseConnectionString(dbType, db.AppName, port, username, password, data.DatabaseName.ValueString()))
+}
+
+// databaseConnectionString returns the connection URL for a database engine.
+func databaseConnectionString(dbType, host string, port int64, username, password, databaseName string) string {
+	scheme := map[string]string{
+		"postgres": "postgresql",
+		"mysql":    "mysql",
+		"mariadb":  "mysql",
+		"mongo":    "mongodb",
+		"redis":    "redis",
+	}[dbType]
+
+	u := url.URL{
+		Scheme: scheme,
+		User:   url.UserPassword(username, password),
+		Host:   fmt.Sprintf("%s:%d", host, port),
+	}
+	if databaseName != "" {
+		u.Path = "/" + databaseName
+	}
+	return u.String()
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// splitImportID splits a slash separated import ID into one non-empty value
+// per named part. The names are only used in the error message.
+func splitImportID(id string, parts ...string) ([]string, error) {
+	values := strings.Split(strings.TrimSpace(id), "/")
+	if len(values) != len(parts) {
+		return nil, fmt.Errorf("expected format %s, got %q", strings.Join(parts, "/"), id)
+	}
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+		if values[i] == "" {
+			return nil, fmt.Errorf("expected format %s, got %q", strings.Join(parts, "/"), id)
+		}
+	}
+	return values, nil
+}
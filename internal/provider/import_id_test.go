@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitImportID(t *testing.T) {
+	tests := []struct {
+		id    string
+		parts []string
+		want  []string
+	}{
+		{"app-1/red-1", []string{"application_id", "redirect_id"}, []string{"app-1", "red-1"}},
+		{" compose / comp-1 / mount-1 ", []string{"service_type", "service_id", "mount_id"}, []string{"compose", "comp-1", "mount-1"}},
+		{"red-1", []string{"application_id", "redirect_id"}, nil},
+		{"app-1/", []string{"application_id", "redirect_id"}, nil},
+		{"/red-1", []string{"application_id", "redirect_id"}, nil},
+		{"compose//mount-1", []string{"service_type", "service_id", "mount_id"}, nil},
+		{"app-1/red-1/extra", []string{"application_id", "redirect_id"}, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.id, func(t *testing.T) {
+			got, err := splitImportID(tc.id, tc.parts...)
+			if tc.want == nil {
+				if err == nil || !strings.Contains(err.Error(), strings.Join(tc.parts, "/")) {
+					t.Fatalf("expected a format error, got %v (%q)", err, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -2,26 +2,35 @@ package provider
 
 import (
 	"context"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/j0bit/terraform-provider-dokploy/internal/client"
 )
 
 var _ provider.Provider = &DokployProvider{}
 var _ provider.ProviderWithFunctions = &DokployProvider{}
+var _ provider.ProviderWithEphemeralResources = &DokployProvider{}
 
 type DokployProvider struct {
 	version string
 }
 
 type DokployProviderModel struct {
-	Host   types.String `tfsdk:"host"`
-	ApiKey types.String `tfsdk:"api_key"`
+	Host            types.String `tfsdk:"host"`
+	ApiKey          types.String `tfsdk:"api_key"`
+	Profile         types.String `tfsdk:"profile"`
+	CredentialsFile types.String `tfsdk:"credentials_file"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait    types.String `tfsdk:"retry_max_wait"`
+	RequestTimeout  types.String `tfsdk:"request_timeout"`
 }
 
 func (p *DokployProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -33,13 +42,33 @@ func (p *DokployProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				Required:    true,
-				Description: "The URL of your Dokploy instance (e.g., https://dokploy.example.com/api)",
+				Optional:    true,
+				Description: "The URL of your Dokploy instance (e.g., https://dokploy.example.com/api). Falls back to the DOKPLOY_HOST environment variable, then to the selected credentials profile.",
 			},
 			"api_key": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "Your Dokploy API Key",
+				Description: "Your Dokploy API Key. Falls back to the DOKPLOY_API_KEY environment variable, then to the selected credentials profile.",
+			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the profile to read from the credentials file. Falls back to the DOKPLOY_PROFILE environment variable, then to \"default\".",
+			},
+			"credentials_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to an INI style credentials file with [profile] sections containing host and api_key. Falls back to the DOKPLOY_CREDENTIALS_FILE environment variable, then to ~/.config/dokploy/credentials.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of retries for transient API failures (network errors, 429, 502, 503 and 504 responses). Mutations are only retried when Dokploy did not process them. Defaults to 4; set to 0 to disable retries.",
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Optional:    true,
+				Description: "Upper bound for the wait between retries, as a Go duration such as \"30s\" or \"2m\". Also caps Retry-After values sent by the server. Defaults to 30s.",
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout for a single API request, as a Go duration such as \"30s\" or \"2m\". Set to \"0\" to only bound requests by the resource timeouts blocks. Defaults to 30s.",
 			},
 		},
 	}
@@ -67,16 +96,34 @@ func (p *DokployProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
-	if config.Host.IsNull() || config.ApiKey.IsNull() {
+	if config.Host.IsUnknown() || config.ApiKey.IsUnknown() || config.Profile.IsUnknown() || config.CredentialsFile.IsUnknown() {
+		return
+	}
+
+	creds, diags := resolveProviderCredentials(config, os.Getenv)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	tflog.Debug(ctx, "Configuring Dokploy client", map[string]interface{}{
+		"host":           creds.Host,
+		"host_source":    creds.HostSource,
+		"api_key_source": creds.APIKeySource,
+	})
+
 	// Create client
-	c := client.NewDokployClient(config.Host.ValueString(), config.ApiKey.ValueString())
+	c := client.NewDokployClient(creds.Host, creds.APIKey)
+	resp.Diagnostics.Append(applyRetryConfig(c, config)...)
+	resp.Diagnostics.Append(applyRequestTimeout(c, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Make client available to resources
 	resp.ResourceData = c
 	resp.DataSourceData = c
+	resp.EphemeralResourceData = c
 }
 
 func (p *DokployProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -89,16 +136,39 @@ func (p *DokployProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewBackupDestinationResource,
 		NewDomainResource,
 		NewPortResource,
+		NewMountResource,
+		NewRedirectResource,
+		NewBasicAuthResource,
+		NewCertificateResource,
 		NewEnvironmentVariablesResource,
 		NewProjectEnvironmentVariablesResource,
+		NewEnvironmentEnvironmentVariablesResource,
 		NewSSHKeyResource,
 		NewVolumeBackupResource,
+		NewDatabaseBackupResource,
 		NewTraefikConfigResource,
+		NewDeploymentResource,
+		NewServerResource,
+		NewRegistryResource,
 	}
 }
 
 func (p *DokployProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewProjectDataSource,
+		NewEnvironmentDataSource,
+		NewApplicationDataSource,
+		NewComposeDataSource,
+		NewDatabaseDataSource,
+		NewSSHKeyDataSource,
+		NewBackupDestinationDataSource,
+	}
+}
+
+func (p *DokployProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDatabaseCredentialsEphemeralResource,
+	}
 }
 
 func (p *DokployProvider) Functions(_ context.Context) []func() function.Function {
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+const (
+	envHost            = "DOKPLOY_HOST"
+	envAPIKey          = "DOKPLOY_API_KEY"
+	envProfile         = "DOKPLOY_PROFILE"
+	envCredentialsFile = "DOKPLOY_CREDENTIALS_FILE"
+	defaultProfileName = "default"
+)
+
+// providerCredentials holds the resolved connection settings together with a
+// human readable description of where each value came from.
+type providerCredentials struct {
+	Host         string
+	APIKey       string
+	HostSource   string
+	APIKeySource string
+}
+
+type credentialsProfile struct {
+	Host   string
+	APIKey string
+}
+
+// defaultCredentialsFile returns ~/.config/dokploy/credentials, honoring
+// XDG_CONFIG_HOME when it is set.
+func defaultCredentialsFile(getenv func(string) string) string {
+	if dir := getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "dokploy", "credentials")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "dokploy", "credentials")
+}
+
+// parseCredentialsFile reads an INI style credentials file:
+//
+//	[default]
+//	host    = https://dokploy.example.com/api
+//	api_key = xxxxxxxx
+//
+// Lines starting with # or ; are comments. Values may be quoted.
+func parseCredentialsFile(r io.Reader) (map[string]credentialsProfile, error) {
+	profiles := map[string]credentialsProfile{}
+	current := ""
+	lineNo := 0
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: malformed profile header %q", lineNo, line)
+			}
+			current = strings.TrimSpace(line[1 : len(line)-1])
+			if current == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNo)
+			}
+			if _, ok := profiles[current]; !ok {
+				profiles[current] = credentialsProfile{}
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		if current == "" {
+			return nil, fmt.Errorf("line %d: %q is not inside a [profile] section", lineNo, strings.TrimSpace(key))
+		}
+
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+
+		profile := profiles[current]
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "host":
+			profile.Host = value
+		case "api_key":
+			profile.APIKey = value
+		default:
+			// Unknown keys are ignored so the file can be shared with other tools.
+		}
+		profiles[current] = profile
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// resolveProviderCredentials merges the provider block, environment variables
+// and the credentials file, in that order of precedence.
+func resolveProviderCredentials(config DokployProviderModel, getenv func(string) string) (*providerCredentials, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	creds := &providerCredentials{}
+
+	if value, ok := configuredString(config.Host); ok {
+		creds.Host, creds.HostSource = value, "the provider host attribute"
+	} else if value := strings.TrimSpace(getenv(envHost)); value != "" {
+		creds.Host, creds.HostSource = value, "the "+envHost+" environment variable"
+	}
+
+	if value, ok := configuredString(config.ApiKey); ok {
+		creds.APIKey, creds.APIKeySource = value, "the provider api_key attribute"
+	} else if value := strings.TrimSpace(getenv(envAPIKey)); value != "" {
+		creds.APIKey, creds.APIKeySource = value, "the "+envAPIKey+" environment variable"
+	}
+
+	profileName, profileExplicit := configuredString(config.Profile)
+	if !profileExplicit {
+		if value := strings.TrimSpace(getenv(envProfile)); value != "" {
+			profileName, profileExplicit = value, true
+		} else {
+			profileName = defaultProfileName
+		}
+	}
+
+	filePath, pathExplicit := configuredString(config.CredentialsFile)
+	if !pathExplicit {
+		if value := strings.TrimSpace(getenv(envCredentialsFile)); value != "" {
+			filePath, pathExplicit = value, true
+		} else {
+			filePath = defaultCredentialsFile(getenv)
+		}
+	}
+
+	needFile := creds.Host == "" || creds.APIKey == "" || profileExplicit || pathExplicit
+	if needFile && filePath != "" {
+		profile, err := loadCredentialsProfile(filePath, profileName)
+		switch {
+		case err == nil:
+			source := fmt.Sprintf("profile %q in %s", profileName, filePath)
+			if creds.Host == "" && profile.Host != "" {
+				creds.Host, creds.HostSource = profile.Host, source
+			}
+			if creds.APIKey == "" && profile.APIKey != "" {
+				creds.APIKey, creds.APIKeySource = profile.APIKey, source
+			}
+		case errors.Is(err, fs.ErrNotExist) && !profileExplicit && !pathExplicit:
+			// The default credentials file is optional.
+		case errors.Is(err, errProfileNotFound) && !profileExplicit:
+			// The default profile is optional.
+		default:
+			diags.AddError(
+				"Unable to Load Dokploy Credentials File",
+				fmt.Sprintf("Reading profile %q from %s failed: %s", profileName, filePath, err),
+			)
+			return nil, diags
+		}
+	}
+
+	if creds.Host == "" {
+		diags.AddError("Missing Dokploy Host", missingCredentialDetail("host", envHost, profileName, filePath, "API key", creds.APIKeySource))
+	}
+	if creds.APIKey == "" {
+		diags.AddError("Missing Dokploy API Key", missingCredentialDetail("api_key", envAPIKey, profileName, filePath, "host", creds.HostSource))
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return creds, diags
+}
+
+func missingCredentialDetail(attribute, envVar, profileName, filePath, other, otherSource string) string {
+	detail := fmt.Sprintf("The provider could not determine %s. Set it in the provider block or the %s environment variable", attribute, envVar)
+	if filePath != "" {
+		detail += fmt.Sprintf(", or add %s to profile %q in %s", attribute, profileName, filePath)
+	}
+	detail += "."
+	if otherSource != "" {
+		detail += fmt.Sprintf(" The %s was read from %s.", other, otherSource)
+	}
+	return detail
+}
+
+var errProfileNotFound = errors.New("profile not found")
+
+func loadCredentialsProfile(filePath, name string) (*credentialsProfile, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles, err := parseCredentialsFile(f)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errProfileNotFound, name)
+	}
+	return &profile, nil
+}
+
+// applyRetryConfig overrides the client's retry policy with the provider
+// block settings, leaving the client defaults in place for unset values.
+func applyRetryConfig(c *client.DokployClient, config DokployProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries := config.MaxRetries.ValueInt64()
+		if maxRetries < 0 {
+			diags.AddAttributeError(path.Root("max_retries"), "Invalid Retry Configuration", "max_retries must be zero or greater.")
+		} else {
+			c.MaxRetries = int(maxRetries)
+		}
+	}
+
+	if value, ok := configuredString(config.RetryMaxWait); ok {
+		maxWait, err := time.ParseDuration(value)
+		switch {
+		case err != nil:
+			diags.AddAttributeError(path.Root("retry_max_wait"), "Invalid Retry Configuration", fmt.Sprintf("retry_max_wait must be a duration such as \"30s\": %s", err))
+		case maxWait <= 0:
+			diags.AddAttributeError(path.Root("retry_max_wait"), "Invalid Retry Configuration", "retry_max_wait must be greater than zero.")
+		default:
+			c.RetryMaxWait = maxWait
+			if c.RetryMinWait > maxWait {
+				c.RetryMinWait = maxWait
+			}
+		}
+	}
+
+	return diags
+}
+
+// applyRequestTimeout sets the per-request HTTP timeout from request_timeout.
+// Zero disables it, leaving requests bounded only by the operation context.
+func applyRequestTimeout(c *client.DokployClient, config DokployProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, ok := configuredString(config.RequestTimeout)
+	if !ok {
+		return diags
+	}
+
+	timeout, err := time.ParseDuration(value)
+	switch {
+	case err != nil:
+		diags.AddAttributeError(path.Root("request_timeout"), "Invalid Request Timeout", fmt.Sprintf("request_timeout must be a duration such as \"30s\": %s", err))
+	case timeout < 0:
+		diags.AddAttributeError(path.Root("request_timeout"), "Invalid Request Timeout", "request_timeout must be zero or greater.")
+	default:
+		c.HTTPClient.Timeout = timeout
+	}
+
+	return diags
+}
+
+func configuredString(v types.String) (string, bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return "", false
+	}
+	value := strings.TrimSpace(v.ValueString())
+	return value, value != ""
+}
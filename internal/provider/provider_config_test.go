@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func testProviderModel() DokployProviderModel {
+	return DokployProviderModel{
+		Host:            types.StringNull(),
+		ApiKey:          types.StringNull(),
+		Profile:         types.StringNull(),
+		CredentialsFile: types.StringNull(),
+		MaxRetries:      types.Int64Null(),
+		RetryMaxWait:    types.StringNull(),
+		RequestTimeout:  types.StringNull(),
+	}
+}
+
+func testGetenv(values map[string]string) func(string) string {
+	return func(key string) string {
+		return values[key]
+	}
+}
+
+func writeCredentialsFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write credentials file: %v", err)
+	}
+	return path
+}
+
+func TestParseCredentialsFile(t *testing.T) {
+	profiles, err := parseCredentialsFile(strings.NewReader(`
+# shared credentials
+[default]
+host    = https://dokploy.example.com/api
+api_key = "default-key"
+
+[staging]
+; staging instance
+host = https://staging.example.com/api
+api_key = 'staging-key'
+region = ignored
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := profiles["default"]; got.Host != "https://dokploy.example.com/api" || got.APIKey != "default-key" {
+		t.Fatalf("unexpected default profile: %+v", got)
+	}
+	if got := profiles["staging"]; got.Host != "https://staging.example.com/api" || got.APIKey != "staging-key" {
+		t.Fatalf("unexpected staging profile: %+v", got)
+	}
+}
+
+func TestParseCredentialsFile_RejectsKeysOutsideProfile(t *testing.T) {
+	if _, err := parseCredentialsFile(strings.NewReader("host = https://example.com\n")); err == nil {
+		t.Fatal("expected error for key outside profile section")
+	}
+}
+
+func TestResolveProviderCredentials_Precedence(t *testing.T) {
+	path := writeCredentialsFile(t, "[default]\nhost = https://file.example.com\napi_key = file-key\n")
+
+	config := testProviderModel()
+	config.Host = types.StringValue("https://config.example.com")
+	config.CredentialsFile = types.StringValue(path)
+
+	creds, diags := resolveProviderCredentials(config, testGetenv(map[string]string{
+		envHost:   "https://env.example.com",
+		envAPIKey: "env-key",
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if creds.Host != "https://config.example.com" || creds.HostSource != "the provider host attribute" {
+		t.Fatalf("unexpected host: %q from %q", creds.Host, creds.HostSource)
+	}
+	if creds.APIKey != "env-key" || creds.APIKeySource != "the DOKPLOY_API_KEY environment variable" {
+		t.Fatalf("unexpected api key: %q from %q", creds.APIKey, creds.APIKeySource)
+	}
+}
+
+func TestResolveProviderCredentials_NamedProfile(t *testing.T) {
+	path := writeCredentialsFile(t, "[default]\nhost = https://file.example.com\napi_key = file-key\n\n[staging]\nhost = https://staging.example.com\napi_key = staging-key\n")
+
+	config := testProviderModel()
+	config.Profile = types.StringValue("staging")
+
+	creds, diags := resolveProviderCredentials(config, testGetenv(map[string]string{
+		envCredentialsFile: path,
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if creds.Host != "https://staging.example.com" || creds.APIKey != "staging-key" {
+		t.Fatalf("unexpected credentials: %+v", creds)
+	}
+	if !strings.Contains(creds.HostSource, `profile "staging"`) {
+		t.Fatalf("unexpected host source: %q", creds.HostSource)
+	}
+}
+
+func TestResolveProviderCredentials_MissingProfileIsError(t *testing.T) {
+	path := writeCredentialsFile(t, "[default]\nhost = https://file.example.com\napi_key = file-key\n")
+
+	config := testProviderModel()
+	config.Profile = types.StringValue("production")
+	config.CredentialsFile = types.StringValue(path)
+
+	_, diags := resolveProviderCredentials(config, testGetenv(nil))
+	if !diags.HasError() {
+		t.Fatal("expected error for missing profile")
+	}
+}
+
+func TestResolveProviderCredentials_ReportsMissingValue(t *testing.T) {
+	config := testProviderModel()
+
+	_, diags := resolveProviderCredentials(config, testGetenv(map[string]string{
+		envHost:           "https://env.example.com",
+		"XDG_CONFIG_HOME": t.TempDir(),
+	}))
+	if len(diags) != 1 {
+		t.Fatalf("expected exactly one diagnostic, got %d: %v", len(diags), diags)
+	}
+	if diags[0].Summary() != "Missing Dokploy API Key" {
+		t.Fatalf("unexpected summary: %s", diags[0].Summary())
+	}
+	if !strings.Contains(diags[0].Detail(), "DOKPLOY_HOST environment variable") {
+		t.Fatalf("detail does not mention host source: %s", diags[0].Detail())
+	}
+}
+
+func TestApplyRetryConfig(t *testing.T) {
+	config := testProviderModel()
+	config.MaxRetries = types.Int64Value(7)
+	config.RetryMaxWait = types.StringValue("2m")
+
+	c := client.NewDokployClient("https://dokploy.example.com/api", "key")
+	if diags := applyRetryConfig(c, config); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if c.MaxRetries != 7 || c.RetryMaxWait != 2*time.Minute {
+		t.Fatalf("unexpected retry settings: %d %v", c.MaxRetries, c.RetryMaxWait)
+	}
+
+	config.RetryMaxWait = types.StringValue("forever")
+	if diags := applyRetryConfig(c, config); !diags.HasError() {
+		t.Fatal("expected error for invalid duration")
+	}
+}
+
+func TestApplyRequestTimeout(t *testing.T) {
+	config := testProviderModel()
+	c := client.NewDokployClient("https://dokploy.example.com/api", "key")
+
+	if diags := applyRequestTimeout(c, config); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if c.HTTPClient.Timeout != client.DefaultRequestTimeout {
+		t.Fatalf("expected default timeout, got %v", c.HTTPClient.Timeout)
+	}
+
+	config.RequestTimeout = types.StringValue("5m")
+	if diags := applyRequestTimeout(c, config); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if c.HTTPClient.Timeout != 5*time.Minute {
+		t.Fatalf("expected 5m timeout, got %v", c.HTTPClient.Timeout)
+	}
+
+	config.RequestTimeout = types.StringValue("0")
+	if diags := applyRequestTimeout(c, config); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if c.HTTPClient.Timeout != 0 {
+		t.Fatalf("expected timeout to be disabled, got %v", c.HTTPClient.Timeout)
+	}
+
+	for _, value := range []string{"soon", "-1s"} {
+		config.RequestTimeout = types.StringValue(value)
+		if diags := applyRequestTimeout(c, config); !diags.HasError() {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+}
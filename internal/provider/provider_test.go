@@ -1,11 +1,21 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
 	"github.com/joho/godotenv"
 )
 
@@ -29,3 +39,91 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("DOKPLOY_API_KEY must be set for acceptance tests")
 	}
 }
+
+// testNullTimeouts returns an unset timeouts block for resource models.
+func testNullTimeouts() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	})}
+}
+
+// testConfigureResource configures r with a client for serverURL.
+func testConfigureResource(t *testing.T, r resource.Resource, serverURL string) {
+	t.Helper()
+	var resp resource.ConfigureResponse
+	r.(resource.ResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{ProviderData: client.NewDokployClient(serverURL, "test-key")}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("configuring resource: %v", resp.Diagnostics)
+	}
+}
+
+// testResourceState returns state of r holding model, or a null state when
+// model is nil.
+func testResourceState(t *testing.T, r resource.Resource, model any) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if model == nil {
+		return state
+	}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("building state: %v", diags)
+	}
+	return state
+}
+
+// testReadResource configures r with a client for serverURL and runs Read
+// with model as the prior state.
+func testReadResource(t *testing.T, r resource.Resource, serverURL string, model any) *resource.ReadResponse {
+	t.Helper()
+	testConfigureResource(t, r, serverURL)
+
+	state := testResourceState(t, r, model)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	return resp
+}
+
+// testImportState runs ImportState of r for id and returns the imported id
+// and application_id.
+func testImportState(t *testing.T, r resource.Resource, id string) (types.String, types.String, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	resp := &resource.ImportStateResponse{State: testResourceState(t, r, nil)}
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+	var importedID, applicationID types.String
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &importedID)...)
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("application_id"), &applicationID)...)
+	}
+	return importedID, applicationID, resp.Diagnostics
+}
+
+func TestResourceSchemas_HaveTimeouts(t *testing.T) {
+	ctx := context.Background()
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "dokploy"}, &metadata)
+
+		var resp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: schema diagnostics: %v", metadata.TypeName, resp.Diagnostics)
+		}
+		if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Fatalf("%s: invalid schema: %v", metadata.TypeName, diags)
+		}
+		if _, ok := resp.Schema.Blocks["timeouts"]; !ok {
+			t.Errorf("%s: missing timeouts block", metadata.TypeName)
+		}
+	}
+}
@@ -5,19 +5,23 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/j0bit/terraform-provider-dokploy/internal/client"
 )
 
 var _ resource.Resource = &ApplicationResource{}
 var _ resource.ResourceWithImportState = &ApplicationResource{}
+var _ resource.ResourceWithValidateConfig = &ApplicationResource{}
 
 func NewApplicationResource() resource.Resource {
 	return &ApplicationResource{}
@@ -31,6 +35,7 @@ type ApplicationResourceModel struct {
 	ID                 types.String `tfsdk:"id"`
 	ProjectID          types.String `tfsdk:"project_id"`
 	EnvironmentID      types.String `tfsdk:"environment_id"`
+	ServerID           types.String `tfsdk:"server_id"`
 	Name               types.String `tfsdk:"name"`
 	RepositoryURL      types.String `tfsdk:"repository_url"`
 	Branch             types.String `tfsdk:"branch"`
@@ -38,6 +43,12 @@ type ApplicationResourceModel struct {
 	DockerfilePath     types.String `tfsdk:"dockerfile_path"`
 	DockerContextPath  types.String `tfsdk:"docker_context_path"`
 	DockerBuildStage   types.String `tfsdk:"docker_build_stage"`
+	BuildArgs          types.Map    `tfsdk:"build_args"`
+	BuildSecrets       types.Map    `tfsdk:"build_secrets"`
+	HerokuVersion      types.String `tfsdk:"heroku_version"`
+	PublishDirectory   types.String `tfsdk:"publish_directory"`
+	IsStaticSpa        types.Bool   `tfsdk:"is_static_spa"`
+	RailpackVersion    types.String `tfsdk:"railpack_version"`
 	CustomGitUrl       types.String `tfsdk:"custom_git_url"`
 	CustomGitBranch    types.String `tfsdk:"custom_git_branch"`
 	CustomGitSSHKeyID  types.String `tfsdk:"custom_git_ssh_key_id"`
@@ -48,8 +59,10 @@ type ApplicationResourceModel struct {
 	// Docker provider fields
 	DockerImage                           types.String `tfsdk:"docker_image"`
 	RegistryURL                           types.String `tfsdk:"registry_url"`
+	RegistryID                            types.String `tfsdk:"registry_id"`
 	AutoDeploy                            types.Bool   `tfsdk:"auto_deploy"`
 	DeployOnCreate                        types.Bool   `tfsdk:"deploy_on_create"`
+	DesiredState                          types.String `tfsdk:"desired_state"`
 	IsPreviewDeploymentsActive            types.Bool   `tfsdk:"is_preview_deployments_active"`
 	PreviewWildcard                       types.String `tfsdk:"preview_wildcard"`
 	PreviewPort                           types.Int64  `tfsdk:"preview_port"`
@@ -74,6 +87,26 @@ type ApplicationResourceModel struct {
 	TriggerType      types.String `tfsdk:"trigger_type"`
 	Ports            types.List   `tfsdk:"ports"`
 	Mounts           types.List   `tfsdk:"mounts"`
+	// Resource limits and Swarm settings
+	Resources      types.Object `tfsdk:"resources"`
+	Replicas       types.Int64  `tfsdk:"replicas"`
+	Mode           types.String `tfsdk:"mode"`
+	Command        types.String `tfsdk:"command"`
+	Args           types.List   `tfsdk:"args"`
+	HealthCheck    types.Object `tfsdk:"health_check"`
+	RestartPolicy  types.Object `tfsdk:"restart_policy"`
+	Placement      types.Object `tfsdk:"placement"`
+	UpdateConfig   types.Object `tfsdk:"update_config"`
+	RollbackConfig types.Object `tfsdk:"rollback_config"`
+	Networks       types.List   `tfsdk:"networks"`
+
+	// Write-only registry password
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+
+	// Provider-only settings
+	WaitForDeployment types.Object   `tfsdk:"wait_for_deployment"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ApplicationPortResourceModel struct {
@@ -127,10 +160,59 @@ func optionalBoolPointerFromPlan(value types.Bool) *bool {
 	return &result
 }
 
+// validateApplicationRegistry rejects inline registry credentials next to a
+// registry reference, since only one of them can be sent to Dokploy.
+func validateApplicationRegistry(config ApplicationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if config.RegistryID.IsNull() || config.RegistryID.IsUnknown() {
+		return diags
+	}
+	for _, field := range []struct {
+		name  string
+		value types.String
+	}{
+		{"registry_url", config.RegistryURL},
+		{"username", config.Username},
+		{"password", config.Password},
+		{"password_wo", config.PasswordWO},
+	} {
+		if field.value.IsNull() || field.value.IsUnknown() {
+			continue
+		}
+		diags.AddAttributeError(path.Root(field.name), "Conflicting Registry Configuration",
+			fmt.Sprintf("registry_id cannot be combined with %s; the credentials are taken from the registry.", field.name))
+	}
+	return diags
+}
+
+// registryIDForUpdate returns the registry reference to send on update. A
+// registry_id removed from the configuration is detached explicitly.
+func registryIDForUpdate(plan, state types.String) *string {
+	if id := optionalStringPointer(plan); id != nil {
+		return id
+	}
+	if optionalStringFromPlan(state) != "" {
+		detach := ""
+		return &detach
+	}
+	return nil
+}
+
+// buildDockerProviderConfig builds the docker provider payload. With
+// registry_id, Dokploy takes the credentials from the registry, so inline
+// credentials are cleared instead of copied and rotating the registry
+// password reaches the application.
 func buildDockerProviderConfig(plan ApplicationResourceModel) map[string]interface{} {
 	cfg := map[string]interface{}{
 		"dockerImage": plan.DockerImage.ValueString(),
 	}
+	if registryID := optionalStringFromPlan(plan.RegistryID); registryID != "" {
+		cfg["registryId"] = registryID
+		cfg["registryUrl"] = ""
+		cfg["username"] = ""
+		cfg["password"] = ""
+		return cfg
+	}
 	if !plan.RegistryURL.IsNull() && !plan.RegistryURL.IsUnknown() && plan.RegistryURL.ValueString() != "" {
 		cfg["registryUrl"] = plan.RegistryURL.ValueString()
 	}
@@ -155,7 +237,7 @@ func (r *ApplicationResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_application"
 }
 
-func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ApplicationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -174,6 +256,13 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional: true,
 				Computed: true,
 			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the dokploy_server to run this application on. Defaults to the Dokploy host itself. Changing it forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"name": schema.StringAttribute{
 				Required: true,
 			},
@@ -187,8 +276,9 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Default:  nil,
 			},
 			"build_type": schema.StringAttribute{
-				Optional: true,
-				Computed: true,
+				Optional:    true,
+				Computed:    true,
+				Description: "Build type: nixpacks, dockerfile, heroku_buildpacks, paketo_buildpacks, static or railpack. Defaults to nixpacks.",
 			},
 			"dockerfile_path": schema.StringAttribute{
 				Optional: true,
@@ -202,6 +292,33 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional: true,
 				Computed: true,
 			},
+			"build_args": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Build arguments passed to the build, e.g. as --build-arg for dockerfile builds.",
+			},
+			"build_secrets": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Secrets mounted into dockerfile builds with RUN --mount=type=secret. Other build types ignore them.",
+			},
+			"heroku_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Heroku builder stack version, e.g. 24. Only used with build_type heroku_buildpacks.",
+			},
+			"publish_directory": schema.StringAttribute{
+				Optional:    true,
+				Description: "Directory served after the build. Only used with build_type nixpacks or static.",
+			},
+			"is_static_spa": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Serve index.html for unknown paths so client side routing works. Only used with build_type static.",
+			},
+			"railpack_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Railpack version used for the build. Only used with build_type railpack.",
+			},
 			"custom_git_url": schema.StringAttribute{
 				Optional: true,
 			},
@@ -225,6 +342,8 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional:  true,
 				Sensitive: true,
 			},
+			"password_wo":         writeOnlyStringAttribute("password"),
+			"password_wo_version": writeOnlyVersionAttribute("password"),
 			"docker_image": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -232,13 +351,19 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"registry_url": schema.StringAttribute{
 				Optional: true,
 			},
+			"registry_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of a dokploy_registry to pull the image from and push builds to. Conflicts with registry_url, username and password.",
+			},
 			"auto_deploy": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
 			},
 			"deploy_on_create": schema.BoolAttribute{
-				Optional: true,
+				Optional:    true,
+				Description: "Deploy the application after creating it. Setting desired_state to running also deploys it on creation.",
 			},
+			"desired_state": desiredStateAttribute("application"),
 			"is_preview_deployments_active": schema.BoolAttribute{
 				Optional: true,
 			},
@@ -348,6 +473,13 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts":            timeouts.BlockAll(ctx),
+			"wait_for_deployment": waitForDeploymentBlock("application"),
+		},
+	}
+	for name, attribute := range applicationSwarmAttributes() {
+		resp.Schema.Attributes[name] = attribute
 	}
 }
 
@@ -363,6 +495,35 @@ func (r *ApplicationResource) Configure(_ context.Context, req resource.Configur
 	r.client = client
 }
 
+func (r *ApplicationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplicationResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateApplicationBuild(config)...)
+	resp.Diagnostics.Append(validateApplicationSwarm(ctx, config)...)
+	resp.Diagnostics.Append(validateApplicationRegistry(config)...)
+	resp.Diagnostics.Append(validateWriteOnlyPair(config.Password, config.PasswordWO, config.PasswordWOVersion, "password", false)...)
+	resp.Diagnostics.Append(validateDesiredState(config.DesiredState)...)
+	resp.Diagnostics.Append(validateDesiredStateDeploy(config.DesiredState, config.DeployOnCreate)...)
+}
+
+// applicationPasswordFromConfig returns plan with the registry password taken
+// from password_wo when that is configured. The returned restore function
+// clears it again before the plan is saved to state.
+func applicationPasswordFromConfig(ctx context.Context, config tfsdk.Config, plan *ApplicationResourceModel) (func(), diag.Diagnostics) {
+	writeOnly, diags := writeOnlyString(ctx, config, "password_wo")
+	if writeOnly.IsNull() || writeOnly.IsUnknown() {
+		return func() {}, diags
+	}
+	password := plan.Password
+	plan.Password = writeOnly
+	return func() { plan.Password = password }, diags
+}
+
 func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ApplicationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -370,6 +531,25 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	restorePassword, diags := applicationPasswordFromConfig(ctx, req.Config, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	wait, diags := expandWaitForDeployment(ctx, plan.WaitForDeployment)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	if plan.Branch.IsUnknown() || plan.Branch.IsNull() {
 		plan.Branch = types.StringValue("main")
@@ -423,6 +603,19 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 	}
 
+	swarm, diags := expandApplicationSwarm(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	buildArgs, diags := expandBuildVariables(ctx, plan.BuildArgs, types.MapNull(types.StringType))
+	resp.Diagnostics.Append(diags...)
+	buildSecrets, diags := expandBuildVariables(ctx, plan.BuildSecrets, types.MapNull(types.StringType))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	autoDeployConfigured := !plan.AutoDeploy.IsNull() && !plan.AutoDeploy.IsUnknown()
 	desiredAutoDeploy := false
 	if autoDeployConfigured {
@@ -447,6 +640,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		Name:                                  plan.Name.ValueString(),
 		ProjectID:                             plan.ProjectID.ValueString(),
 		EnvironmentID:                         plan.EnvironmentID.ValueString(),
+		ServerID:                              plan.ServerID.ValueString(),
 		RepositoryURL:                         plan.RepositoryURL.ValueString(),
 		Branch:                                plan.Branch.ValueString(),
 		BuildType:                             plan.BuildType.ValueString(),
@@ -462,6 +656,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		Password:                              plan.Password.ValueString(),
 		DockerImage:                           plan.DockerImage.ValueString(),
 		RegistryURL:                           plan.RegistryURL.ValueString(),
+		RegistryID:                            optionalStringPointer(plan.RegistryID),
 		AutoDeploy:                            createAutoDeploy,
 		IsPreviewDeploymentsActive:            optionalBoolPointerFromPlan(plan.IsPreviewDeploymentsActive),
 		PreviewWildcard:                       optionalStringFromPlan(plan.PreviewWildcard),
@@ -476,9 +671,13 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		PreviewBuildArgs:                      optionalStringFromPlan(plan.PreviewBuildArgs),
 		PreviewLabels:                         previewLabels,
 		LabelsSwarm:                           labels,
+		ApplicationSwarm:                      swarm,
+		BuildArgs:                             buildArgs,
+		BuildSecrets:                          buildSecrets,
 	}
+	applicationBuildSettings(&app, plan)
 
-	createdApp, err := r.client.CreateApplication(app)
+	createdApp, err := r.client.CreateApplication(ctx, app)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating application", err.Error())
 		return
@@ -526,7 +725,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 			publishMode = "ingress"
 		}
 
-		_, err := r.client.CreatePort(client.Port{
+		_, err := r.client.CreatePort(ctx, client.Port{
 			ApplicationID: createdApp.ID,
 			PublishedPort: portPlan.PublishedPort.ValueInt64(),
 			TargetPort:    portPlan.TargetPort.ValueInt64(),
@@ -543,7 +742,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	for i, mountPlan := range managedMounts {
 		managedMount := normalizeApplicationMountPlan(mountPlan)
-		_, err := r.client.CreateMount(client.Mount{
+		_, err := r.client.CreateMount(ctx, client.Mount{
 			ApplicationID: createdApp.ID,
 			MountType:     managedMount.MountType,
 			MountPath:     managedMount.MountPath,
@@ -558,6 +757,15 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 	}
 
+	if applicationUsesBuildTypeSettings(plan) {
+		buildApp := app
+		buildApp.ID = createdApp.ID
+		if err := r.client.SaveBuildType(ctx, buildApp); err != nil {
+			resp.Diagnostics.AddError("Error saving build type", err.Error())
+			return
+		}
+	}
+
 	// Save GitHub provider if GitHub fields are provided
 	if !plan.GithubID.IsNull() && !plan.GithubID.IsUnknown() && plan.GithubID.ValueString() != "" {
 		githubConfig := map[string]interface{}{
@@ -594,7 +802,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 			}
 		}
 
-		err := r.client.SaveGithubProvider(createdApp.ID, githubConfig)
+		err := r.client.SaveGithubProvider(ctx, createdApp.ID, githubConfig)
 		if err != nil {
 			resp.Diagnostics.AddWarning("GitHub Provider Setup Failed",
 				fmt.Sprintf("Application created but GitHub provider configuration failed: %s", err.Error()))
@@ -603,15 +811,15 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Save Docker provider if source_type is docker
 	if plan.SourceType.ValueString() == "docker" {
-		dockerConfig := buildDockerProviderConfig(plan)
-		if err := r.client.SaveDockerProvider(createdApp.ID, dockerConfig); err != nil {
+		err := r.client.SaveDockerProvider(ctx, createdApp.ID, buildDockerProviderConfig(plan))
+		if err != nil {
 			resp.Diagnostics.AddWarning("Docker Provider Setup Failed",
 				fmt.Sprintf("Application created but docker provider configuration failed: %s", err.Error()))
 		}
 	}
 
 	if (len(managedPorts) > 0 || len(managedMounts) > 0) && desiredAutoDeploy && !createdApp.AutoDeploy {
-		updatedApp, err := r.client.UpdateApplication(client.Application{
+		updatedApp, err := r.client.UpdateApplication(ctx, client.Application{
 			ID:                                    createdApp.ID,
 			Name:                                  app.Name,
 			ProjectID:                             app.ProjectID,
@@ -645,6 +853,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 			PreviewBuildArgs:                      app.PreviewBuildArgs,
 			PreviewLabels:                         app.PreviewLabels,
 			LabelsSwarm:                           app.LabelsSwarm,
+			ApplicationSwarm:                      app.ApplicationSwarm,
 		})
 		if err != nil {
 			resp.Diagnostics.AddWarning(
@@ -662,17 +871,30 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		plan.AutoDeploy = types.BoolValue(createdApp.AutoDeploy)
 	}
 
-	shouldTriggerDeploy := !plan.DeployOnCreate.IsNull() && plan.DeployOnCreate.ValueBool()
+	shouldTriggerDeploy := !plan.DeployOnCreate.IsNull() && plan.DeployOnCreate.ValueBool() || isDesiredState(plan.DesiredState, desiredStateRunning)
+	deployTriggerFailed := false
 	// For inline managed ports/mounts with deferred autoDeploy, avoid duplicate deploys.
 	if shouldTriggerDeploy && (len(managedPorts) == 0 && len(managedMounts) == 0 || !createdApp.AutoDeploy) {
-		err := r.client.DeployApplication(createdApp.ID)
+		err := r.client.DeployApplication(ctx, createdApp.ID)
 		if err != nil {
+			deployTriggerFailed = true
 			resp.Diagnostics.AddWarning("Deployment Trigger Failed", fmt.Sprintf("Application created but deployment failed to trigger: %s", err.Error()))
 		}
 	}
 
+	restorePassword()
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	if wait != nil && !deployTriggerFailed {
+		// The application is new, so any deployment it has was started by this
+		// apply. Waiting for a new one covers deploys Dokploy has not listed yet.
+		var known map[string]bool
+		if shouldTriggerDeploy {
+			known = map[string]bool{}
+		}
+		resp.Diagnostics.Append(wait.awaitDeployment(ctx, r.client, "application", createdApp.ID, known)...)
+	}
 }
 
 func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -683,9 +905,17 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	app, err := r.client.GetApplication(state.ID.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	app, err := r.client.GetApplication(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -707,6 +937,11 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 	} else {
 		state.EnvironmentID = types.StringNull()
 	}
+	if app.ServerID != "" {
+		state.ServerID = types.StringValue(app.ServerID)
+	} else {
+		state.ServerID = types.StringNull()
+	}
 
 	// Computed fields - always set them, but preserve state if API returns empty
 	if app.RepositoryURL != "" {
@@ -759,8 +994,17 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 		state.DockerImage = types.StringValue("")
 	}
 
-	// registry_url - only update if it was set in config (matches custom_git_url pattern)
-	if app.RegistryURL != "" {
+	if app.RegistryID != nil && *app.RegistryID != "" {
+		state.RegistryID = types.StringValue(*app.RegistryID)
+	} else {
+		state.RegistryID = types.StringNull()
+	}
+
+	// registry_url - only update if it was set in config (matches custom_git_url pattern).
+	// With registry_id the URL comes from the registry and is not tracked here.
+	if !state.RegistryID.IsNull() {
+		state.RegistryURL = types.StringNull()
+	} else if app.RegistryURL != "" {
 		state.RegistryURL = types.StringValue(app.RegistryURL)
 	} else if !state.RegistryURL.IsNull() {
 		state.RegistryURL = types.StringNull()
@@ -768,6 +1012,7 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// AutoDeploy is Computed boolean - always set from API
 	state.AutoDeploy = types.BoolValue(app.AutoDeploy)
+	state.DesiredState = flattenDesiredState(app.ApplicationStatus, state.DesiredState)
 
 	// Optional custom git fields
 	if app.CustomGitUrl != "" {
@@ -790,7 +1035,10 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 	} else if !state.CustomGitBuildPath.IsNull() {
 		state.CustomGitBuildPath = types.StringNull()
 	}
-	if app.Username != "" {
+	// With registry_id the credentials belong to the registry.
+	if !state.RegistryID.IsNull() {
+		state.Username = types.StringNull()
+	} else if app.Username != "" {
 		state.Username = types.StringValue(app.Username)
 	} else if !state.Username.IsNull() {
 		state.Username = types.StringNull()
@@ -903,6 +1151,21 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 			state.Labels = emptyLabels
 		}
 	}
+	resp.Diagnostics.Append(flattenApplicationSwarm(ctx, app.ApplicationSwarm, &state)...)
+
+	state.BuildArgs, diags = flattenBuildVariables(ctx, app.BuildArgs, state.BuildArgs)
+	resp.Diagnostics.Append(diags...)
+	state.BuildSecrets, diags = flattenBuildVariables(ctx, app.BuildSecrets, state.BuildSecrets)
+	resp.Diagnostics.Append(diags...)
+	state.HerokuVersion = flattenBuildTypeString(app.HerokuVersion, state.HerokuVersion)
+	state.PublishDirectory = flattenBuildTypeString(app.PublishDirectory, state.PublishDirectory)
+	state.RailpackVersion = flattenBuildTypeString(app.RailpackVersion, state.RailpackVersion)
+	if !state.IsStaticSpa.IsNull() && app.IsStaticSpa != nil {
+		state.IsStaticSpa = types.BoolValue(*app.IsStaticSpa)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// Don't read password back
 
 	// Optional GitHub Provider fields - only update if they were set in config
@@ -1013,6 +1276,19 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	restorePassword, diags := applicationPasswordFromConfig(ctx, req.Config, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	var state ApplicationResourceModel
 	diags = req.State.Get(ctx, &state)
@@ -1025,6 +1301,12 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		plan.ID = state.ID
 	}
 
+	wait, diags := expandWaitForDeployment(ctx, plan.WaitForDeployment)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if plan.Branch.IsUnknown() {
 		plan.Branch = types.StringValue("main")
 	}
@@ -1060,6 +1342,20 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 	}
 
+	swarm, diags := expandApplicationSwarm(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clearRemovedApplicationSwarm(&swarm, plan, state)
+	buildArgs, diags := expandBuildVariables(ctx, plan.BuildArgs, state.BuildArgs)
+	resp.Diagnostics.Append(diags...)
+	buildSecrets, diags := expandBuildVariables(ctx, plan.BuildSecrets, state.BuildSecrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	app := client.Application{
 		ID:                                    plan.ID.ValueString(),
 		Name:                                  plan.Name.ValueString(),
@@ -1080,6 +1376,7 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		Password:                              plan.Password.ValueString(),
 		DockerImage:                           plan.DockerImage.ValueString(),
 		RegistryURL:                           plan.RegistryURL.ValueString(),
+		RegistryID:                            registryIDForUpdate(plan.RegistryID, state.RegistryID),
 		AutoDeploy:                            plan.AutoDeploy.ValueBool(),
 		IsPreviewDeploymentsActive:            optionalBoolPointerFromPlan(plan.IsPreviewDeploymentsActive),
 		PreviewWildcard:                       optionalStringFromPlan(plan.PreviewWildcard),
@@ -1094,14 +1391,27 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		PreviewBuildArgs:                      optionalStringFromPlan(plan.PreviewBuildArgs),
 		PreviewLabels:                         previewLabels,
 		LabelsSwarm:                           labels,
+		ApplicationSwarm:                      swarm,
+		BuildArgs:                             buildArgs,
+		BuildSecrets:                          buildSecrets,
 	}
+	applicationBuildSettings(&app, plan)
 
-	updatedApp, err := r.client.UpdateApplication(app)
+	updatedApp, err := r.client.UpdateApplication(ctx, app)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating application", err.Error())
 		return
 	}
 
+	// Build type settings are only stored by saveBuildType. Also call it when
+	// they were removed so Dokploy drops them.
+	if applicationUsesBuildTypeSettings(plan) || applicationUsesBuildTypeSettings(state) {
+		if err := r.client.SaveBuildType(ctx, app); err != nil {
+			resp.Diagnostics.AddError("Error saving build type", err.Error())
+			return
+		}
+	}
+
 	plan.Name = types.StringValue(updatedApp.Name)
 	plan.EnvironmentID = types.StringValue(updatedApp.EnvironmentID)
 	plan.AutoDeploy = types.BoolValue(updatedApp.AutoDeploy)
@@ -1142,7 +1452,7 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 			}
 		}
 
-		err := r.client.SaveGithubProvider(updatedApp.ID, githubConfig)
+		err := r.client.SaveGithubProvider(ctx, updatedApp.ID, githubConfig)
 		if err != nil {
 			resp.Diagnostics.AddWarning("GitHub Provider Update Failed",
 				fmt.Sprintf("Application updated but GitHub provider configuration failed: %s", err.Error()))
@@ -1151,15 +1461,26 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// Update Docker provider if source_type is docker
 	if plan.SourceType.ValueString() == "docker" {
-		dockerConfig := buildDockerProviderConfig(plan)
-		if err := r.client.SaveDockerProvider(updatedApp.ID, dockerConfig); err != nil {
+		err := r.client.SaveDockerProvider(ctx, updatedApp.ID, buildDockerProviderConfig(plan))
+		if err != nil {
 			resp.Diagnostics.AddWarning("Docker Provider Update Failed",
 				fmt.Sprintf("Application updated but docker provider configuration failed: %s", err.Error()))
 		}
 	}
 
+	resp.Diagnostics.Append(applyDesiredState(ctx, r.client, "application", updatedApp.ID, plan.DesiredState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restorePassword()
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	// A stopped application never reports done, so there is nothing to wait for.
+	if wait != nil && !isDesiredState(plan.DesiredState, desiredStateStopped) {
+		resp.Diagnostics.Append(wait.awaitDeployment(ctx, r.client, "application", updatedApp.ID, nil)...)
+	}
 }
 
 func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -1170,9 +1491,17 @@ func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := r.client.DeleteApplication(state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteApplication(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError("Error deleting application", err.Error())
@@ -151,3 +151,22 @@ func TestBuildDockerProviderConfig_AllFieldsSet(t *testing.T) {
 		t.Fatalf("password: got %v want %q", got, want)
 	}
 }
+
+func TestBuildDockerProviderConfig_RegistryIDClearsInlineCredentials(t *testing.T) {
+	cfg := buildDockerProviderConfig(ApplicationResourceModel{
+		DockerImage: types.StringValue("ghcr.io/acme/api:1.2.3"),
+		RegistryID:  types.StringValue("reg-1"),
+		RegistryURL: types.StringNull(),
+		Username:    types.StringNull(),
+		Password:    types.StringNull(),
+	})
+
+	if got, want := cfg["registryId"], "reg-1"; got != want {
+		t.Fatalf("registryId: got %v want %q", got, want)
+	}
+	for _, key := range []string{"registryUrl", "username", "password"} {
+		if got := cfg[key]; got != "" {
+			t.Fatalf("%s should be cleared when registry_id is set, got %#v", key, got)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +17,7 @@ import (
 
 var _ resource.Resource = &BackupDestinationResource{}
 var _ resource.ResourceWithImportState = &BackupDestinationResource{}
+var _ resource.ResourceWithValidateConfig = &BackupDestinationResource{}
 
 func NewBackupDestinationResource() resource.Resource {
 	return &BackupDestinationResource{}
@@ -34,13 +36,18 @@ type BackupDestinationResourceModel struct {
 	Endpoint        types.String `tfsdk:"endpoint"`
 	AccessKeyID     types.String `tfsdk:"access_key_id"`
 	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+
+	SecretAccessKeyWO        types.String `tfsdk:"secret_access_key_wo"`
+	SecretAccessKeyWOVersion types.Int64  `tfsdk:"secret_access_key_wo_version"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *BackupDestinationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_backup_destination"
 }
 
-func (r *BackupDestinationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *BackupDestinationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Dokploy backup destination (for example an S3 bucket).",
 		Attributes: map[string]schema.Attribute{
@@ -78,10 +85,15 @@ func (r *BackupDestinationResource) Schema(_ context.Context, _ resource.SchemaR
 				Description: "S3 access key ID.",
 			},
 			"secret_access_key": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "S3 secret access key.",
+				Description: "S3 secret access key. Exactly one of secret_access_key and secret_access_key_wo must be set.",
 			},
+			"secret_access_key_wo":         writeOnlyStringAttribute("secret_access_key"),
+			"secret_access_key_wo_version": writeOnlyVersionAttribute("secret_access_key"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
 		},
 	}
 }
@@ -98,6 +110,17 @@ func (r *BackupDestinationResource) Configure(_ context.Context, req resource.Co
 	r.client = client
 }
 
+func (r *BackupDestinationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config BackupDestinationResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateWriteOnlyPair(config.SecretAccessKey, config.SecretAccessKeyWO, config.SecretAccessKeyWOVersion, "secret_access_key", true)...)
+}
+
 func (r *BackupDestinationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan BackupDestinationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -106,18 +129,31 @@ func (r *BackupDestinationResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	if plan.Type.IsUnknown() || plan.Type.IsNull() || strings.TrimSpace(plan.Type.ValueString()) == "" {
 		plan.Type = types.StringValue("s3")
 	}
+	secretAccessKey, diags := secretFromConfig(ctx, req.Config, plan.SecretAccessKey, "secret_access_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	created, err := r.client.CreateBackupDestination(client.BackupDestination{
+	created, err := r.client.CreateBackupDestination(ctx, client.BackupDestination{
 		Name:            plan.Name.ValueString(),
 		Type:            plan.Type.ValueString(),
 		Bucket:          plan.Bucket.ValueString(),
 		Region:          plan.Region.ValueString(),
 		Endpoint:        plan.Endpoint.ValueString(),
 		AccessKeyID:     plan.AccessKeyID.ValueString(),
-		SecretAccessKey: plan.SecretAccessKey.ValueString(),
+		SecretAccessKey: secretAccessKey,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating backup destination", err.Error())
@@ -144,9 +180,17 @@ func (r *BackupDestinationResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	destination, err := r.client.GetBackupDestination(state.ID.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	destination, err := r.client.GetBackupDestination(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -168,11 +212,24 @@ func (r *BackupDestinationResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	if plan.Type.IsUnknown() || plan.Type.IsNull() || strings.TrimSpace(plan.Type.ValueString()) == "" {
 		plan.Type = types.StringValue("s3")
 	}
+	secretAccessKey, diags := secretFromConfig(ctx, req.Config, plan.SecretAccessKey, "secret_access_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	updated, err := r.client.UpdateBackupDestination(client.BackupDestination{
+	updated, err := r.client.UpdateBackupDestination(ctx, client.BackupDestination{
 		ID:              plan.ID.ValueString(),
 		Name:            plan.Name.ValueString(),
 		Type:            plan.Type.ValueString(),
@@ -180,7 +237,7 @@ func (r *BackupDestinationResource) Update(ctx context.Context, req resource.Upd
 		Region:          plan.Region.ValueString(),
 		Endpoint:        plan.Endpoint.ValueString(),
 		AccessKeyID:     plan.AccessKeyID.ValueString(),
-		SecretAccessKey: plan.SecretAccessKey.ValueString(),
+		SecretAccessKey: secretAccessKey,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating backup destination", err.Error())
@@ -201,9 +258,17 @@ func (r *BackupDestinationResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	err := r.client.DeleteBackupDestination(state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteBackupDestination(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError("Error deleting backup destination", err.Error())
@@ -253,7 +318,9 @@ func applyBackupDestinationState(state BackupDestinationResourceModel, destinati
 	if secretAccessKey == "" {
 		secretAccessKey = strings.TrimSpace(destination.SecretKey)
 	}
-	if secretAccessKey != "" {
+	// Only refresh a secret kept in state; with secret_access_key_wo it must
+	// stay out of state. Imported destinations have no name yet and adopt it.
+	if secretAccessKey != "" && (!state.SecretAccessKey.IsNull() || state.Name.IsNull()) {
 		state.SecretAccessKey = types.StringValue(secretAccessKey)
 	}
 
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testBasicAuthModel(id string) BasicAuthResourceModel {
+	return BasicAuthResourceModel{
+		ID:                types.StringValue(id),
+		ApplicationID:     types.StringValue("app-1"),
+		Username:          types.StringValue("admin"),
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: types.Int64Value(1),
+		Timeouts:          testNullTimeouts(),
+	}
+}
+
+func TestBasicAuthRead(t *testing.T) {
+	const entries = `{"applicationId":"app-1","security":[
+		{"securityId":"sec-1","username":"ops","password":"ops-secret"},
+		{"securityId":"sec-2","username":"renamed","password":"rotated"}
+	]}`
+
+	tests := []struct {
+		name        string
+		id          string
+		status      int
+		body        string
+		wantRemoved bool
+		wantError   bool
+	}{
+		{name: "matches by ID", id: "sec-2", status: http.StatusOK, body: entries},
+		{name: "missing credential", id: "sec-3", status: http.StatusOK, body: entries, wantRemoved: true},
+		{name: "deleted application", id: "sec-1", status: http.StatusNotFound, body: `{"message":"Application not found","code":"NOT_FOUND"}`, wantRemoved: true},
+		{name: "server error", id: "sec-1", status: http.StatusBadRequest, body: `{"message":"invalid input","code":"BAD_REQUEST"}`, wantError: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			resp := testReadResource(t, NewBasicAuthResource(), server.URL, testBasicAuthModel(tc.id))
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != tc.wantRemoved {
+				t.Fatalf("expected removed=%v, got state %v", tc.wantRemoved, resp.State.Raw)
+			}
+			if tc.wantRemoved || tc.wantError {
+				return
+			}
+
+			var state BasicAuthResourceModel
+			if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+				t.Fatalf("reading state: %v", diags)
+			}
+			if state.ID.ValueString() != tc.id || state.Username.ValueString() != "renamed" || !state.PasswordWO.IsNull() {
+				t.Fatalf("unexpected state: %+v", state)
+			}
+		})
+	}
+}
+
+func TestBasicAuthWrite_SendsPassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		response string
+		state    any
+		apply    func(r resource.Resource, config tfsdk.Config, plan tfsdk.Plan, state tfsdk.State) (tfsdk.State, diag.Diagnostics)
+	}{
+		{
+			name:     "create",
+			endpoint: "/security.create",
+			response: `{"securityId":"sec-1","applicationId":"app-1","username":"renamed"}`,
+			apply: func(r resource.Resource, config tfsdk.Config, plan tfsdk.Plan, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+				resp := &resource.CreateResponse{State: state}
+				r.Create(context.Background(), resource.CreateRequest{Config: config, Plan: plan}, resp)
+				return resp.State, resp.Diagnostics
+			},
+		},
+		{
+			// Only the username changes, but Dokploy needs the password too.
+			name:     "update",
+			endpoint: "/security.update",
+			response: `true`,
+			state:    testBasicAuthModel("sec-1"),
+			apply: func(r resource.Resource, config tfsdk.Config, plan tfsdk.Plan, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+				resp := &resource.UpdateResponse{State: state}
+				r.Update(context.Background(), resource.UpdateRequest{Config: config, Plan: plan, State: state}, resp)
+				return resp.State, resp.Diagnostics
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var payload map[string]string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tc.endpoint {
+					t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					t.Fatalf("failed to decode payload: %v", err)
+				}
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			r := NewBasicAuthResource()
+			testConfigureResource(t, r, server.URL)
+
+			model := testBasicAuthModel("sec-1")
+			model.Username = types.StringValue("renamed")
+			if tc.state == nil {
+				model.ID = types.StringUnknown()
+			}
+			plan := testResourceState(t, r, model)
+			model.PasswordWO = types.StringValue("s3cret")
+			config := testResourceState(t, r, model)
+
+			state, diags := tc.apply(r,
+				tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+				tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+				testResourceState(t, r, tc.state))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if payload["username"] != "renamed" || payload["password"] != "s3cret" {
+				t.Fatalf("unexpected payload: %#v", payload)
+			}
+
+			var passwordWO types.String
+			if diags := state.GetAttribute(context.Background(), path.Root("password_wo"), &passwordWO); diags.HasError() || !passwordWO.IsNull() {
+				t.Fatalf("expected the password to stay out of state, got %v (%v)", passwordWO, diags)
+			}
+		})
+	}
+}
+
+func TestBasicAuthImportState(t *testing.T) {
+	tests := []struct {
+		id        string
+		wantError bool
+	}{
+		{id: "app-1/sec-1"},
+		{id: "sec-1", wantError: true},
+		{id: "app-1/", wantError: true},
+		{id: "app-1/sec-1/extra", wantError: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.id, func(t *testing.T) {
+			id, applicationID, diags := testImportState(t, NewBasicAuthResource(), tc.id)
+			if diags.HasError() != tc.wantError {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !tc.wantError && (id.ValueString() != "sec-1" || applicationID.ValueString() != "app-1") {
+				t.Fatalf("unexpected import: id=%v application_id=%v", id, applicationID)
+			}
+		})
+	}
+}
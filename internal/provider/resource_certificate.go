@@ -0,0 +1,380 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ resource.Resource = &CertificateResource{}
+var _ resource.ResourceWithImportState = &CertificateResource{}
+var _ resource.ResourceWithValidateConfig = &CertificateResource{}
+var _ resource.ResourceWithModifyPlan = &CertificateResource{}
+
+func NewCertificateResource() resource.Resource {
+	return &CertificateResource{}
+}
+
+type CertificateResource struct {
+	client *client.DokployClient
+}
+
+type CertificateResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	CertificateData types.String `tfsdk:"certificate_data"`
+	PrivateKey      types.String `tfsdk:"private_key"`
+	AutoRenew       types.Bool   `tfsdk:"auto_renew"`
+	ServerID        types.String `tfsdk:"server_id"`
+	ExpiresAt       types.String `tfsdk:"expires_at"`
+
+	PrivateKeyWO        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion types.Int64  `tfsdk:"private_key_wo_version"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *CertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (r *CertificateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads a custom TLS certificate to Dokploy. Traefik serves it for the domains it covers; " +
+			"reference it from dokploy_domain with certificate_id. Dokploy cannot update certificates, so every change replaces it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_data": schema.StringAttribute{
+				Required:    true,
+				Description: "PEM encoded certificate, followed by any intermediate certificates of the chain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key of the certificate. Exactly one of private_key and private_key_wo must be set. Dokploy does not return the key, so an imported certificate is only replaced once the key changes after the first apply.",
+				PlanModifiers: []planmodifier.String{
+					replaceIfPriorKnown(),
+				},
+			},
+			"private_key_wo":         writeOnlyStringAttribute("private_key"),
+			"private_key_wo_version": writeOnlyVersionAttribute("private_key", replaceInt64IfPriorKnown()),
+			"auto_renew": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether Dokploy should renew the certificate automatically. Defaults to false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Remote server to install the certificate on. Defaults to the Dokploy server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiry of the certificate in RFC 3339 format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *CertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *CertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config CertificateResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateWriteOnlyPair(config.PrivateKey, config.PrivateKeyWO, config.PrivateKeyWOVersion, "private_key", true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are part of the configuration, so the pair can be
+	// checked whichever variant holds the key.
+	privateKey := config.PrivateKey
+	if !config.PrivateKeyWO.IsNull() {
+		privateKey = config.PrivateKeyWO
+	}
+	certificateData, certOK := configuredString(config.CertificateData)
+	keyData, keyOK := configuredString(privateKey)
+	if !certOK || !keyOK {
+		return
+	}
+	if _, err := parseCertificatePair(certificateData, keyData); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("certificate_data"), "Invalid Certificate", err.Error())
+	}
+}
+
+// ModifyPlan checks the validity period of the certificate. Only a
+// certificate that is about to be uploaded, by a create or a replacement,
+// must not have expired; an expired certificate that is already in Dokploy
+// must not block plans that leave it alone, including its own destruction.
+func (r *CertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var certificateData types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("certificate_data"), &certificateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	value, ok := configuredString(certificateData)
+	if !ok {
+		return
+	}
+
+	uploading := req.State.Raw.IsNull() || len(resp.RequiresReplace) > 0
+	if !uploading {
+		var prior types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("certificate_data"), &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		uploading = !prior.Equal(certificateData)
+	}
+
+	resp.Diagnostics.Append(certificateValidityDiagnostics(value, time.Now(), uploading)...)
+}
+
+func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CertificateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	privateKey, diags := secretFromConfig(ctx, req.Config, plan.PrivateKey, "private_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateCertificate(ctx, client.Certificate{
+		Name:            plan.Name.ValueString(),
+		CertificateData: plan.CertificateData.ValueString(),
+		PrivateKey:      privateKey,
+		AutoRenew:       plan.AutoRenew.ValueBool(),
+		ServerID:        plan.ServerID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating certificate", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	plan.ExpiresAt = certificateExpiresAt(plan.CertificateData.ValueString())
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CertificateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	certificate, err := r.client.GetCertificate(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading certificate", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(certificate.Name)
+	state.CertificateData = types.StringValue(certificate.CertificateData)
+	state.AutoRenew = types.BoolValue(certificate.AutoRenew)
+	state.ServerID = types.StringNull()
+	if certificate.ServerID != "" {
+		state.ServerID = types.StringValue(certificate.ServerID)
+	}
+	state.ExpiresAt = certificateExpiresAt(certificate.CertificateData)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every certificate attribute forces a new resource, so only
+	// Terraform-side settings such as timeouts can change here.
+	var plan CertificateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CertificateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteCertificate(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting certificate", err.Error())
+		return
+	}
+}
+
+func (r *CertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// parseCertificatePair checks that the private key belongs to the leaf
+// certificate of the PEM chain and returns the leaf.
+func parseCertificatePair(certificateData, privateKey string) (*x509.Certificate, error) {
+	pair, err := tls.X509KeyPair([]byte(certificateData), []byte(privateKey))
+	if err != nil {
+		return nil, fmt.Errorf("certificate_data and the private key do not form a valid PEM pair: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate_data: %w", err)
+	}
+	return leaf, nil
+}
+
+// certificateValidityDiagnostics reports a certificate that is not valid at
+// now. Uploading an expired certificate is an error; an expired certificate
+// that already exists and a certificate that is not valid yet, which may be
+// clock skew, only warn.
+func certificateValidityDiagnostics(certificateData string, now time.Time, uploading bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	leaf := parseLeafCertificate(certificateData)
+	if leaf == nil {
+		return diags
+	}
+
+	switch {
+	case now.After(leaf.NotAfter):
+		detail := fmt.Sprintf("Certificate %q expired on %s.", leaf.Subject.CommonName, leaf.NotAfter.UTC().Format(time.RFC3339))
+		if uploading {
+			diags.AddAttributeError(path.Root("certificate_data"), "Expired Certificate", detail)
+		} else {
+			diags.AddAttributeWarning(path.Root("certificate_data"), "Expired Certificate", detail+" Replace certificate_data with a renewed certificate.")
+		}
+	case now.Before(leaf.NotBefore):
+		diags.AddAttributeWarning(path.Root("certificate_data"), "Certificate Not Yet Valid",
+			fmt.Sprintf("Certificate %q is not valid before %s.", leaf.Subject.CommonName, leaf.NotBefore.UTC().Format(time.RFC3339)))
+	}
+	return diags
+}
+
+// parseLeafCertificate returns the first certificate of a PEM chain, or nil
+// when it cannot be parsed.
+func parseLeafCertificate(certificateData string) *x509.Certificate {
+	block, _ := pem.Decode([]byte(certificateData))
+	if block == nil {
+		return nil
+	}
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+	return leaf
+}
+
+// certificateExpiresAt returns the expiry of the leaf certificate of a PEM
+// chain, or null when it cannot be parsed.
+func certificateExpiresAt(certificateData string) types.String {
+	leaf := parseLeafCertificate(certificateData)
+	if leaf == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(leaf.NotAfter.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testCertificatePEM(t *testing.T, notBefore, notAfter time.Time) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	certificateData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certificateData), string(privateKey)
+}
+
+func TestParseCertificatePair(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	certificateData, privateKey := testCertificatePEM(t, now.AddDate(0, -1, 0), now.AddDate(0, 2, 0))
+	_, otherKey := testCertificatePEM(t, now.AddDate(0, -1, 0), now.AddDate(0, 2, 0))
+	expiredData, expiredKey := testCertificatePEM(t, now.AddDate(-1, 0, 0), now.AddDate(0, -1, 0))
+
+	leaf, err := parseCertificatePair(certificateData, privateKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if leaf.Subject.CommonName != "example.com" {
+		t.Fatalf("unexpected leaf: %v", leaf.Subject)
+	}
+	if _, err := parseCertificatePair(expiredData, expiredKey); err != nil {
+		t.Fatalf("expected an expired pair to match, got %v", err)
+	}
+
+	for name, pair := range map[string][2]string{
+		"mismatched key": {certificateData, otherKey},
+		"not PEM":        {"certificate", privateKey},
+	} {
+		if _, err := parseCertificatePair(pair[0], pair[1]); err == nil || !strings.Contains(err.Error(), "valid PEM pair") {
+			t.Fatalf("%s: expected a PEM pair error, got %v", name, err)
+		}
+	}
+}
+
+func TestCertificateValidityDiagnostics(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	valid, _ := testCertificatePEM(t, now.AddDate(0, -1, 0), now.AddDate(0, 2, 0))
+	expired, _ := testCertificatePEM(t, now.AddDate(-1, 0, 0), now.AddDate(0, -1, 0))
+	future, _ := testCertificatePEM(t, now.AddDate(0, 0, 1), now.AddDate(1, 0, 0))
+
+	tests := []struct {
+		name            string
+		certificateData string
+		uploading       bool
+		errors          int
+		warnings        int
+	}{
+		{"valid", valid, true, 0, 0},
+		{"expired on create", expired, true, 1, 0},
+		{"expired in state", expired, false, 0, 1},
+		{"not yet valid", future, true, 0, 1},
+		{"not PEM", "certificate", true, 0, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := certificateValidityDiagnostics(tc.certificateData, now, tc.uploading)
+			if diags.ErrorsCount() != tc.errors || diags.WarningsCount() != tc.warnings {
+				t.Fatalf("expected %d errors and %d warnings, got %v", tc.errors, tc.warnings, diags)
+			}
+		})
+	}
+}
+
+func testCertificatePlanState(t *testing.T, certificateData string) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewCertificateResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if certificateData == "" {
+		return state
+	}
+	diags := state.Set(ctx, CertificateResourceModel{
+		ID:                  types.StringValue("cert-1"),
+		Name:                types.StringValue("wildcard"),
+		CertificateData:     types.StringValue(certificateData),
+		PrivateKey:          types.StringNull(),
+		AutoRenew:           types.BoolValue(false),
+		ServerID:            types.StringNull(),
+		ExpiresAt:           types.StringNull(),
+		PrivateKeyWO:        types.StringNull(),
+		PrivateKeyWOVersion: types.Int64Null(),
+		Timeouts:            testNullTimeouts(),
+	})
+	if diags.HasError() {
+		t.Fatalf("building state: %v", diags)
+	}
+	return state
+}
+
+func TestCertificateModifyPlan_RejectsUploadingExpiredCertificate(t *testing.T) {
+	now := time.Now()
+	valid, _ := testCertificatePEM(t, now.AddDate(0, -1, 0), now.AddDate(0, 2, 0))
+	expired, _ := testCertificatePEM(t, now.AddDate(-1, 0, 0), now.AddDate(0, -1, 0))
+	renewedExpired, _ := testCertificatePEM(t, now.AddDate(-1, 0, 0), now.AddDate(0, -1, 0))
+
+	tests := []struct {
+		name     string
+		state    string
+		plan     string
+		replace  bool
+		errors   int
+		warnings int
+	}{
+		{"create", "", expired, false, 1, 0},
+		{"replace certificate_data", valid, renewedExpired, false, 1, 0},
+		{"replace for another attribute", expired, expired, true, 1, 0},
+		{"unchanged", expired, expired, false, 0, 1},
+		{"valid create", "", valid, false, 0, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := testCertificatePlanState(t, tc.plan)
+			req := resource.ModifyPlanRequest{
+				State: testCertificatePlanState(t, tc.state),
+				Plan:  tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			if tc.replace {
+				resp.RequiresReplace = path.Paths{path.Root("name")}
+			}
+			NewCertificateResource().(resource.ResourceWithModifyPlan).ModifyPlan(context.Background(), req, resp)
+			if resp.Diagnostics.ErrorsCount() != tc.errors || resp.Diagnostics.WarningsCount() != tc.warnings {
+				t.Fatalf("expected %d errors and %d warnings, got %v", tc.errors, tc.warnings, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCertificateExpiresAt(t *testing.T) {
+	notAfter := time.Date(2027, 1, 2, 3, 4, 5, 0, time.UTC)
+	certificateData, _ := testCertificatePEM(t, notAfter.AddDate(-1, 0, 0), notAfter)
+
+	if got := certificateExpiresAt(certificateData).ValueString(); got != "2027-01-02T03:04:05Z" {
+		t.Fatalf("unexpected expiry %q", got)
+	}
+	if !certificateExpiresAt("not a certificate").IsNull() {
+		t.Fatal("expected null expiry for invalid data")
+	}
+}
@@ -3,8 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +17,7 @@ import (
 
 var _ resource.Resource = &ComposeResource{}
 var _ resource.ResourceWithImportState = &ComposeResource{}
+var _ resource.ResourceWithValidateConfig = &ComposeResource{}
 
 func NewComposeResource() resource.Resource {
 	return &ComposeResource{}
@@ -30,6 +31,7 @@ type ComposeResourceModel struct {
 	ID                     types.String `tfsdk:"id"`
 	ProjectID              types.String `tfsdk:"project_id"`
 	EnvironmentID          types.String `tfsdk:"environment_id"`
+	ServerID               types.String `tfsdk:"server_id"`
 	Name                   types.String `tfsdk:"name"`
 	ComposeFileContent     types.String `tfsdk:"compose_file_content"`
 	SourceType             types.String `tfsdk:"source_type"`
@@ -39,14 +41,18 @@ type ComposeResourceModel struct {
 	ComposePath            types.String `tfsdk:"compose_path"`
 	AutoDeploy             types.Bool   `tfsdk:"auto_deploy"`
 	DeployOnCreate         types.Bool   `tfsdk:"deploy_on_create"`
+	DesiredState           types.String `tfsdk:"desired_state"`
 	DeleteVolumesOnDestroy types.Bool   `tfsdk:"delete_volumes_on_destroy"`
+	WaitForDeployment      types.Object `tfsdk:"wait_for_deployment"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ComposeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_compose"
 }
 
-func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ComposeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -70,6 +76,13 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"name": schema.StringAttribute{
 				Required: true,
 			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the dokploy_server to run this compose stack on. Defaults to the Dokploy host itself. Changing it forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"compose_file_content": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -105,8 +118,10 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"deploy_on_create": schema.BoolAttribute{
-				Optional: true,
+				Optional:    true,
+				Description: "Deploy the compose stack after creating it. Setting desired_state to running also deploys it on creation.",
 			},
+			"desired_state": desiredStateAttribute("compose stack"),
 			"delete_volumes_on_destroy": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -116,6 +131,10 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "If true, deletes attached volumes when this compose stack is destroyed.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts":            timeouts.BlockAll(ctx),
+			"wait_for_deployment": waitForDeploymentBlock("compose stack"),
+		},
 	}
 }
 
@@ -131,6 +150,18 @@ func (r *ComposeResource) Configure(_ context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
+func (r *ComposeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ComposeResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDesiredState(config.DesiredState)...)
+	resp.Diagnostics.Append(validateDesiredStateDeploy(config.DesiredState, config.DeployOnCreate)...)
+}
+
 func (r *ComposeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ComposeResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -139,6 +170,20 @@ func (r *ComposeResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	wait, diags := expandWaitForDeployment(ctx, plan.WaitForDeployment)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if plan.ComposePath.IsUnknown() || plan.ComposePath.IsNull() {
 		plan.ComposePath = types.StringValue("./docker-compose.yml")
 	}
@@ -159,6 +204,7 @@ func (r *ComposeResource) Create(ctx context.Context, req resource.CreateRequest
 	comp := client.Compose{
 		Name:              plan.Name.ValueString(),
 		EnvironmentID:     plan.EnvironmentID.ValueString(),
+		ServerID:          plan.ServerID.ValueString(),
 		ComposeFile:       plan.ComposeFileContent.ValueString(),
 		SourceType:        plan.SourceType.ValueString(),
 		CustomGitUrl:      plan.CustomGitUrl.ValueString(),
@@ -168,7 +214,7 @@ func (r *ComposeResource) Create(ctx context.Context, req resource.CreateRequest
 		AutoDeploy:        plan.AutoDeploy.ValueBool(),
 	}
 
-	createdComp, err := r.client.CreateCompose(comp)
+	createdComp, err := r.client.CreateCompose(ctx, comp)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating compose", err.Error())
 		return
@@ -184,16 +230,28 @@ func (r *ComposeResource) Create(ctx context.Context, req resource.CreateRequest
 		plan.ComposeFileContent = types.StringNull()
 	}
 
-	if !plan.DeployOnCreate.IsNull() && plan.DeployOnCreate.ValueBool() && !createdComp.AutoDeploy {
+	shouldTriggerDeploy := !plan.DeployOnCreate.IsNull() && plan.DeployOnCreate.ValueBool() || isDesiredState(plan.DesiredState, desiredStateRunning)
+	deployTriggerFailed := false
+	if shouldTriggerDeploy && !createdComp.AutoDeploy {
 		// Avoid duplicate deployments: Dokploy can already trigger deploys when autoDeploy is enabled.
-		err := r.client.DeployCompose(createdComp.ID)
+		err := r.client.DeployCompose(ctx, createdComp.ID)
 		if err != nil {
+			deployTriggerFailed = true
 			resp.Diagnostics.AddWarning("Deployment Trigger Failed", fmt.Sprintf("Compose stack created but deployment failed to trigger: %s", err.Error()))
 		}
 	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	if wait != nil && !deployTriggerFailed {
+		// The stack is new, so any deployment it has was started by this apply.
+		var known map[string]bool
+		if shouldTriggerDeploy {
+			known = map[string]bool{}
+		}
+		resp.Diagnostics.Append(wait.awaitDeployment(ctx, r.client, "compose", createdComp.ID, known)...)
+	}
 }
 
 func (r *ComposeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -204,9 +262,17 @@ func (r *ComposeResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	comp, err := r.client.GetCompose(state.ID.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	comp, err := r.client.GetCompose(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -216,6 +282,11 @@ func (r *ComposeResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	state.Name = types.StringValue(comp.Name)
 	state.EnvironmentID = types.StringValue(comp.EnvironmentID)
+	if comp.ServerID != "" {
+		state.ServerID = types.StringValue(comp.ServerID)
+	} else {
+		state.ServerID = types.StringNull()
+	}
 	state.ComposeFileContent = types.StringValue(comp.ComposeFile)
 	state.SourceType = types.StringValue(comp.SourceType)
 	state.CustomGitUrl = types.StringValue(comp.CustomGitUrl)
@@ -223,6 +294,7 @@ func (r *ComposeResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.CustomGitSSHKeyID = types.StringValue(comp.CustomGitSSHKeyId)
 	state.ComposePath = types.StringValue(comp.ComposePath)
 	state.AutoDeploy = types.BoolValue(comp.AutoDeploy)
+	state.DesiredState = flattenDesiredState(comp.ComposeStatus, state.DesiredState)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -235,6 +307,20 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	wait, diags := expandWaitForDeployment(ctx, plan.WaitForDeployment)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if plan.DeleteVolumesOnDestroy.IsUnknown() || plan.DeleteVolumesOnDestroy.IsNull() {
 		plan.DeleteVolumesOnDestroy = types.BoolValue(false)
 	}
@@ -252,7 +338,7 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 		AutoDeploy:        plan.AutoDeploy.ValueBool(),
 	}
 
-	updatedComp, err := r.client.UpdateCompose(comp)
+	updatedComp, err := r.client.UpdateCompose(ctx, comp)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating compose", err.Error())
 		return
@@ -263,8 +349,18 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 	plan.SourceType = types.StringValue(updatedComp.SourceType)
 	plan.AutoDeploy = types.BoolValue(updatedComp.AutoDeploy)
 
+	resp.Diagnostics.Append(applyDesiredState(ctx, r.client, "compose", updatedComp.ID, plan.DesiredState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	// A stopped compose stack never reports done, so there is nothing to wait for.
+	if wait != nil && !isDesiredState(plan.DesiredState, desiredStateStopped) {
+		resp.Diagnostics.Append(wait.awaitDeployment(ctx, r.client, "compose", updatedComp.ID, nil)...)
+	}
 }
 
 func (r *ComposeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -275,9 +371,17 @@ func (r *ComposeResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.DeleteCompose(state.ID.ValueString(), state.DeleteVolumesOnDestroy.ValueBool())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteCompose(ctx, state.ID.ValueString(), state.DeleteVolumesOnDestroy.ValueBool())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError("Error deleting compose", err.Error())
@@ -3,11 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,6 +17,7 @@ import (
 
 var _ resource.Resource = &DatabaseResource{}
 var _ resource.ResourceWithImportState = &DatabaseResource{}
+var _ resource.ResourceWithValidateConfig = &DatabaseResource{}
 
 func NewDatabaseResource() resource.Resource {
 	return &DatabaseResource{}
@@ -29,19 +31,33 @@ type DatabaseResourceModel struct {
 	ID            types.String `tfsdk:"id"`
 	ProjectID     types.String `tfsdk:"project_id"`
 	EnvironmentID types.String `tfsdk:"environment_id"`
+	ServerID      types.String `tfsdk:"server_id"`
 	Type          types.String `tfsdk:"type"`
 	Name          types.String `tfsdk:"name"`
 	Password      types.String `tfsdk:"password"`
 	Version       types.String `tfsdk:"version"`
 	InternalPort  types.Int64  `tfsdk:"internal_port"`
 	ExternalPort  types.Int64  `tfsdk:"external_port"`
+
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+
+	DatabaseName types.String `tfsdk:"database_name"`
+	DatabaseUser types.String `tfsdk:"database_user"`
+	DockerImage  types.String `tfsdk:"docker_image"`
+	Command      types.String `tfsdk:"command"`
+	Env          types.Map    `tfsdk:"env"`
+	Resources    types.Object `tfsdk:"resources"`
+	DesiredState types.String `tfsdk:"desired_state"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *DatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_database"
 }
 
-func (r *DatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DatabaseResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -59,6 +75,13 @@ func (r *DatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the dokploy_server to run this database on. Defaults to the Dokploy host itself. Changing it forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"type": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -66,31 +89,72 @@ func (r *DatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"name": schema.StringAttribute{
-				Required: true,
+				Required:    true,
+				Description: "Name of the database. The app name, which is also its host name, is derived from it on creation and does not change.",
+			},
+			"database_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Database created inside the engine. Defaults to name, and is always null for redis. Changing it forces a new resource.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+					replaceIfPriorKnown(),
 				},
 			},
-			"password": schema.StringAttribute{
-				Required:  true,
-				Sensitive: true,
+			"database_user": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "User created inside the engine. Defaults to postgres for postgres, root for mysql and mariadb, mongo for mongo and default for redis. Changing it forces a new resource.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+					replaceIfPriorKnown(),
 				},
 			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of the database user, changed in place. Exactly one of password and password_wo must be set.",
+			},
+			"password_wo":         writeOnlyStringAttribute("password"),
+			"password_wo_version": writeOnlyVersionAttribute("password"),
 			"version": schema.StringAttribute{
-				Optional: true,
+				Optional:    true,
+				Description: "Tag of the engine's official image, such as \"16\". Conflicts with docker_image.",
+			},
+			"docker_image": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Docker image of the database. Defaults to the engine's official image tagged with version. Conflicts with version.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					databaseDockerImageModifier{},
 				},
 			},
+			"command": schema.StringAttribute{
+				Optional:    true,
+				Description: "Command that overrides the default command of the image.",
+			},
+			"env": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Environment variables of the database container.",
+			},
+			"resources":     resourceLimitsAttribute("Memory and CPU reservations and limits of the database container."),
+			"desired_state": databaseDesiredStateAttribute(),
 			"internal_port": schema.Int64Attribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"external_port": schema.Int64Attribute{
-				Computed: true,
+				Optional:    true,
+				Description: "Port to publish the database on. Removing it stops publishing the database.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -106,6 +170,29 @@ func (r *DatabaseResource) Configure(_ context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+func (r *DatabaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DatabaseResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateWriteOnlyPair(config.Password, config.PasswordWO, config.PasswordWOVersion, "password", true)...)
+
+	resp.Diagnostics.Append(validateDesiredState(config.DesiredState)...)
+
+	if !config.DatabaseName.IsNull() && !config.Type.IsUnknown() && config.Type.ValueString() == "redis" {
+		resp.Diagnostics.AddAttributeError(path.Root("database_name"), "Invalid Database Configuration",
+			"database_name cannot be set for redis.")
+	}
+
+	if !config.Version.IsNull() && !config.DockerImage.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("docker_image"), "Invalid Database Configuration",
+			"Only one of version and docker_image can be set.")
+	}
+}
+
 func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan DatabaseResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -114,20 +201,31 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Construct dockerImage from type and version
-	dockerImage := plan.Type.ValueString()
-	if !plan.Version.IsNull() && !plan.Version.IsUnknown() && plan.Version.ValueString() != "" {
-		dockerImage = fmt.Sprintf("%s:%s", plan.Type.ValueString(), plan.Version.ValueString())
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-	db, err := r.client.CreateDatabase(
-		plan.ProjectID.ValueString(),
-		plan.EnvironmentID.ValueString(),
-		plan.Name.ValueString(),
-		plan.Type.ValueString(),
-		plan.Password.ValueString(),
-		dockerImage,
-	)
+	password, diags := secretFromConfig(ctx, req.Config, plan.Password, "password")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	db, err := r.client.CreateDatabase(ctx, client.Database{
+		ProjectID:        plan.ProjectID.ValueString(),
+		EnvironmentID:    plan.EnvironmentID.ValueString(),
+		ServerID:         plan.ServerID.ValueString(),
+		Type:             plan.Type.ValueString(),
+		Name:             plan.Name.ValueString(),
+		DatabaseName:     optionalStringFromPlan(plan.DatabaseName),
+		DatabaseUser:     optionalStringFromPlan(plan.DatabaseUser),
+		DatabasePassword: password,
+		DockerImage:      plan.DockerImage.ValueString(),
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating database", err.Error())
 		return
@@ -135,10 +233,61 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 
 	plan.ID = types.StringValue(db.ID)
 	plan.InternalPort = types.Int64Value(db.InternalPort)
-	plan.ExternalPort = types.Int64Value(db.ExternalPort)
+	plan.DatabaseName = databaseCreatedString(db.DatabaseName, plan.DatabaseName, defaultDatabaseName(plan.Type.ValueString(), plan.Name.ValueString()))
+	plan.DatabaseUser = databaseCreatedString(db.DatabaseUser, plan.DatabaseUser, defaultDatabaseUser(plan.Type.ValueString()))
 
+	// Save the state now so a failure below does not orphan the database.
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if databaseSettingsConfigured(plan) {
+		update := client.Database{ID: db.ID, Type: plan.Type.ValueString(), Name: plan.Name.ValueString(), DockerImage: plan.DockerImage.ValueString()}
+		resp.Diagnostics.Append(expandDatabaseSettings(ctx, &update, plan, DatabaseResourceModel{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if _, err := r.client.UpdateDatabase(ctx, update); err != nil {
+			resp.Diagnostics.AddError("Error updating database settings", err.Error())
+			return
+		}
+	}
+
+	if port := optionalInt64PointerFromPlan(plan.ExternalPort); port != nil {
+		if err := r.client.SaveDatabaseExternalPort(ctx, db.ID, plan.Type.ValueString(), port); err != nil {
+			resp.Diagnostics.AddError("Error saving database external port", err.Error())
+			return
+		}
+	}
+
+	// A new database has no service yet, so it is deployed rather than started.
+	if isDesiredState(plan.DesiredState, desiredStateRunning) {
+		if err := r.client.DeployDatabase(ctx, db.ID, plan.Type.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error deploying database", err.Error())
+			return
+		}
+	}
+}
+
+func databaseDesiredStateAttribute() schema.StringAttribute {
+	attribute := desiredStateAttribute("database")
+	attribute.Description += " A running database is deployed on creation and redeployed when its image, command, env or resources change."
+	return attribute
+}
+
+// databaseCreatedString returns the value Dokploy reports for a setting that
+// defaults on creation, falling back to the planned or default value.
+func databaseCreatedString(value string, plan types.String, fallback types.String) types.String {
+	switch {
+	case value != "":
+		return types.StringValue(value)
+	case !plan.IsNull() && !plan.IsUnknown():
+		return plan
+	default:
+		return fallback
+	}
 }
 
 func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -149,9 +298,17 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	db, err := r.client.GetDatabase(state.ID.ValueString(), state.Type.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	db, err := r.client.GetDatabase(ctx, state.ID.ValueString(), state.Type.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -167,16 +324,117 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if db.EnvironmentID != "" {
 		state.EnvironmentID = types.StringValue(db.EnvironmentID)
 	}
+	if db.ServerID != "" {
+		state.ServerID = types.StringValue(db.ServerID)
+	} else {
+		state.ServerID = types.StringNull()
+	}
+	if db.DockerImage != "" {
+		state.DockerImage = types.StringValue(db.DockerImage)
+	}
+	// Redis never reports a database or user, and databases created before
+	// these attributes existed may not either, so fall back to the defaults.
+	state.DatabaseName = databaseCreatedString(db.DatabaseName, state.DatabaseName, defaultDatabaseName(state.Type.ValueString(), state.Name.ValueString()))
+	state.DatabaseUser = databaseCreatedString(db.DatabaseUser, state.DatabaseUser, defaultDatabaseUser(state.Type.ValueString()))
+	resp.Diagnostics.Append(flattenDatabaseSettings(ctx, db, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// InternalPort/ExternalPort mapping
 	state.InternalPort = types.Int64Value(db.InternalPort)
-	state.ExternalPort = types.Int64Value(db.ExternalPort)
+	state.ExternalPort = databaseExternalPort(db.ExternalPort)
+	state.DesiredState = flattenDesiredState(db.ApplicationStatus, state.DesiredState)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// No update support
+	var plan, state DatabaseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	id := state.ID.ValueString()
+	dbType := state.Type.ValueString()
+
+	// Attributes that were null in state stay unknown after
+	// UseStateForUnknown; they cannot change in place, so resolve them.
+	plan.DatabaseName = databaseCreatedString("", plan.DatabaseName, defaultDatabaseName(dbType, plan.Name.ValueString()))
+	plan.DatabaseUser = databaseCreatedString("", plan.DatabaseUser, defaultDatabaseUser(dbType))
+
+	settingsChanged := databaseSettingsChanged(plan, state)
+	if settingsChanged {
+		update := client.Database{ID: id, Type: dbType, Name: plan.Name.ValueString(), DockerImage: plan.DockerImage.ValueString()}
+		resp.Diagnostics.Append(expandDatabaseSettings(ctx, &update, plan, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if _, err := r.client.UpdateDatabase(ctx, update); err != nil {
+			resp.Diagnostics.AddError("Error updating database", err.Error())
+			return
+		}
+	}
+
+	if !plan.Password.Equal(state.Password) || !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		password, diags := secretFromConfig(ctx, req.Config, plan.Password, "password")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if password != "" {
+			if err := r.client.ChangeDatabasePassword(ctx, id, dbType, password); err != nil {
+				resp.Diagnostics.AddError("Error changing database password", err.Error())
+				return
+			}
+		}
+	}
+
+	if !plan.ExternalPort.Equal(state.ExternalPort) {
+		if err := r.client.SaveDatabaseExternalPort(ctx, id, dbType, optionalInt64PointerFromPlan(plan.ExternalPort)); err != nil {
+			resp.Diagnostics.AddError("Error saving database external port", err.Error())
+			return
+		}
+	}
+
+	// Changed settings only reach a running database when it is redeployed,
+	// which also starts a stopped one.
+	if settingsChanged && isDesiredState(plan.DesiredState, desiredStateRunning) {
+		if err := r.client.DeployDatabase(ctx, id, dbType); err != nil {
+			resp.Diagnostics.AddError("Error deploying database", err.Error())
+			return
+		}
+	} else {
+		resp.Diagnostics.Append(applyDesiredState(ctx, r.client, dbType, id, plan.DesiredState)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// databaseSettingsChanged reports whether the update endpoint needs to be
+// called for the name or the container settings.
+func databaseSettingsChanged(plan, state DatabaseResourceModel) bool {
+	return !plan.Name.Equal(state.Name) ||
+		!plan.DockerImage.Equal(state.DockerImage) ||
+		!plan.Command.Equal(state.Command) ||
+		!plan.Env.Equal(state.Env) ||
+		!plan.Resources.Equal(state.Resources)
 }
 
 func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -187,9 +445,17 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	err := r.client.DeleteDatabaseWithType(state.ID.ValueString(), state.Type.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteDatabaseWithType(ctx, state.ID.ValueString(), state.Type.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError("Error deleting database", err.Error())
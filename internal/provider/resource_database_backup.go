@@ -0,0 +1,394 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ resource.Resource = &DatabaseBackupResource{}
+var _ resource.ResourceWithImportState = &DatabaseBackupResource{}
+var _ resource.ResourceWithValidateConfig = &DatabaseBackupResource{}
+
+func NewDatabaseBackupResource() resource.Resource {
+	return &DatabaseBackupResource{}
+}
+
+type DatabaseBackupResource struct {
+	client *client.DokployClient
+}
+
+type DatabaseBackupResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	DatabaseID      types.String `tfsdk:"database_id"`
+	DatabaseType    types.String `tfsdk:"database_type"`
+	DatabaseName    types.String `tfsdk:"database_name"`
+	DestinationID   types.String `tfsdk:"destination_id"`
+	DestinationName types.String `tfsdk:"destination_name"`
+	CronExpression  types.String `tfsdk:"cron_expression"`
+	Prefix          types.String `tfsdk:"prefix"`
+	KeepLatestCount types.Int64  `tfsdk:"keep_latest_count"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DatabaseBackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_backup"
+}
+
+func (r *DatabaseBackupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a scheduled Dokploy backup of a postgres, mysql, mariadb or mongo database.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the dokploy_database to back up.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Engine of the database. Supported values: postgres, mysql, mariadb, mongo.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the database inside the engine to dump. If omitted, it is resolved from database_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"destination_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Backup destination ID. If omitted, destination_name is resolved to an ID using destination.all.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"destination_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Backup destination name used when destination_id is not provided.",
+			},
+			"cron_expression": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Cron expression controlling backup schedule. Defaults to \"0 3 * * *\".",
+			},
+			"prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Path prefix for backup files in the destination. Defaults to the database name.",
+			},
+			"keep_latest_count": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Number of most recent backups to keep. Defaults to 14.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Whether the backup schedule is enabled. Defaults to true.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *DatabaseBackupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *DatabaseBackupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DatabaseBackupResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDatabaseBackup(config)...)
+}
+
+func (r *DatabaseBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DatabaseBackupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	backup, err := r.backupFromPlan(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid database backup configuration", err.Error())
+		return
+	}
+
+	created, err := r.client.CreateDatabaseBackup(ctx, plan.DatabaseID.ValueString(), backup)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating database backup", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	plan = applyDatabaseBackupState(plan, created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DatabaseBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DatabaseBackupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	backup, err := r.client.GetDatabaseBackup(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading database backup", err.Error())
+		return
+	}
+
+	state = applyDatabaseBackupState(state, backup)
+	if state.DestinationName.IsUnknown() {
+		state.DestinationName = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DatabaseBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DatabaseBackupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	backup, err := r.backupFromPlan(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid database backup configuration", err.Error())
+		return
+	}
+	backup.ID = plan.ID.ValueString()
+
+	updated, err := r.client.UpdateDatabaseBackup(ctx, backup)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating database backup", err.Error())
+		return
+	}
+
+	plan = applyDatabaseBackupState(plan, updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DatabaseBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DatabaseBackupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteDatabaseBackup(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting database backup", err.Error())
+		return
+	}
+}
+
+func (r *DatabaseBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// backupFromPlan fills in defaults, resolves the database and destination
+// names and returns the backup to send to Dokploy.
+func (r *DatabaseBackupResource) backupFromPlan(ctx context.Context, plan *DatabaseBackupResourceModel) (client.DatabaseBackup, error) {
+	databaseType := plan.DatabaseType.ValueString()
+
+	if _, ok := configuredString(plan.DatabaseName); !ok {
+		db, err := r.client.GetDatabase(ctx, plan.DatabaseID.ValueString(), databaseType)
+		if err != nil {
+			return client.DatabaseBackup{}, fmt.Errorf("failed to resolve database_name from database_id: %w", err)
+		}
+		name := db.DatabaseName
+		if name == "" {
+			name = db.Name
+		}
+		plan.DatabaseName = types.StringValue(name)
+	}
+
+	destinationID, err := resolveDestinationID(ctx, r.client, plan.DestinationID, plan.DestinationName)
+	if err != nil {
+		return client.DatabaseBackup{}, err
+	}
+	plan.DestinationID = types.StringValue(destinationID)
+
+	applyDatabaseBackupDefaults(plan)
+
+	return client.DatabaseBackup{
+		DatabaseType:    databaseType,
+		Database:        plan.DatabaseName.ValueString(),
+		Schedule:        plan.CronExpression.ValueString(),
+		Prefix:          plan.Prefix.ValueString(),
+		DestinationID:   destinationID,
+		KeepLatestCount: plan.KeepLatestCount.ValueInt64(),
+		Enabled:         plan.Enabled.ValueBool(),
+	}, nil
+}
+
+// databaseBackupTypes are the engines Dokploy can back up.
+var databaseBackupTypes = []string{"postgres", "mysql", "mariadb", "mongo"}
+
+// validateDatabaseBackup checks database_type. Unknown values are skipped.
+func validateDatabaseBackup(config DatabaseBackupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !config.DatabaseType.IsNull() && !config.DatabaseType.IsUnknown() && !containsString(databaseBackupTypes, config.DatabaseType.ValueString()) {
+		diags.AddAttributeError(path.Root("database_type"), "Invalid Database Backup Configuration",
+			fmt.Sprintf("database_type must be one of %s, got %q.", strings.Join(databaseBackupTypes, ", "), config.DatabaseType.ValueString()))
+	}
+
+	return diags
+}
+
+func applyDatabaseBackupDefaults(plan *DatabaseBackupResourceModel) {
+	if plan.CronExpression.IsUnknown() || plan.CronExpression.IsNull() || strings.TrimSpace(plan.CronExpression.ValueString()) == "" {
+		plan.CronExpression = types.StringValue("0 3 * * *")
+	}
+	if plan.Prefix.IsUnknown() || plan.Prefix.IsNull() || strings.TrimSpace(plan.Prefix.ValueString()) == "" {
+		plan.Prefix = plan.DatabaseName
+	}
+	if plan.KeepLatestCount.IsUnknown() || plan.KeepLatestCount.IsNull() {
+		plan.KeepLatestCount = types.Int64Value(14)
+	}
+	if plan.Enabled.IsUnknown() || plan.Enabled.IsNull() {
+		plan.Enabled = types.BoolValue(true)
+	}
+	if plan.DestinationName.IsUnknown() {
+		plan.DestinationName = types.StringNull()
+	}
+}
+
+func applyDatabaseBackupState(state DatabaseBackupResourceModel, backup *client.DatabaseBackup) DatabaseBackupResourceModel {
+	if backup == nil {
+		return state
+	}
+
+	if strings.TrimSpace(backup.ID) != "" {
+		state.ID = types.StringValue(backup.ID)
+	}
+	if strings.TrimSpace(backup.DatabaseType) != "" {
+		state.DatabaseType = types.StringValue(backup.DatabaseType)
+	}
+	if id := backup.DatabaseID(); id != "" {
+		state.DatabaseID = types.StringValue(id)
+	}
+	if strings.TrimSpace(backup.Database) != "" {
+		state.DatabaseName = types.StringValue(backup.Database)
+	}
+	if strings.TrimSpace(backup.DestinationID) != "" {
+		state.DestinationID = types.StringValue(backup.DestinationID)
+	}
+	if strings.TrimSpace(backup.Schedule) != "" {
+		state.CronExpression = types.StringValue(backup.Schedule)
+	}
+	if strings.TrimSpace(backup.Prefix) != "" {
+		state.Prefix = types.StringValue(backup.Prefix)
+	}
+	if backup.KeepLatestCount > 0 {
+		state.KeepLatestCount = types.Int64Value(backup.KeepLatestCount)
+	}
+	state.Enabled = types.BoolValue(backup.Enabled)
+
+	return state
+}
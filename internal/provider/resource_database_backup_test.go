@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func TestValidateDatabaseBackup(t *testing.T) {
+	tests := []struct {
+		databaseType types.String
+		wantError    bool
+	}{
+		{types.StringValue("postgres"), false},
+		{types.StringValue("mariadb"), false},
+		{types.StringUnknown(), false},
+		{types.StringValue("MariaDB"), true},
+		{types.StringValue("redis"), true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.databaseType.String(), func(t *testing.T) {
+			diags := validateDatabaseBackup(DatabaseBackupResourceModel{DatabaseType: tc.databaseType})
+			if diags.HasError() != tc.wantError {
+				t.Fatalf("expected error %v, got %v", tc.wantError, diags)
+			}
+			if tc.wantError {
+				if d, ok := diags[0].(diag.DiagnosticWithPath); !ok || !d.Path().Equal(path.Root("database_type")) {
+					t.Fatalf("expected a database_type diagnostic, got %v", diags)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyDatabaseBackupDefaults(t *testing.T) {
+	plan := DatabaseBackupResourceModel{
+		DatabaseName:    types.StringValue("shop"),
+		CronExpression:  types.StringUnknown(),
+		Prefix:          types.StringUnknown(),
+		KeepLatestCount: types.Int64Unknown(),
+		Enabled:         types.BoolUnknown(),
+		DestinationName: types.StringUnknown(),
+	}
+	applyDatabaseBackupDefaults(&plan)
+
+	if plan.CronExpression.ValueString() != "0 3 * * *" || plan.Prefix.ValueString() != "shop" {
+		t.Fatalf("unexpected defaults: cron=%q prefix=%q", plan.CronExpression.ValueString(), plan.Prefix.ValueString())
+	}
+	if plan.KeepLatestCount.ValueInt64() != 14 || !plan.Enabled.ValueBool() || !plan.DestinationName.IsNull() {
+		t.Fatalf("unexpected defaults: %+v", plan)
+	}
+}
+
+func TestApplyDatabaseBackupState_FillsImportedBackup(t *testing.T) {
+	state := applyDatabaseBackupState(DatabaseBackupResourceModel{ID: types.StringValue("bk-1")}, &client.DatabaseBackup{
+		ID:              "bk-1",
+		DatabaseType:    "mongo",
+		Database:        "events",
+		Schedule:        "0 */6 * * *",
+		Prefix:          "events",
+		DestinationID:   "dest-1",
+		KeepLatestCount: 5,
+		MongoID:         "mongo-1",
+	})
+
+	if state.DatabaseID.ValueString() != "mongo-1" || state.DatabaseType.ValueString() != "mongo" {
+		t.Fatalf("unexpected database reference: %+v", state)
+	}
+	if state.CronExpression.ValueString() != "0 */6 * * *" || state.KeepLatestCount.ValueInt64() != 5 || state.Enabled.ValueBool() {
+		t.Fatalf("unexpected schedule: %+v", state)
+	}
+}
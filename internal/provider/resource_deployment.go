@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+const (
+	defaultDeploymentPollInterval = 5 * time.Second
+	deploymentLogTailLines        = 40
+)
+
+var _ resource.Resource = &DeploymentResource{}
+
+func NewDeploymentResource() resource.Resource {
+	return &DeploymentResource{}
+}
+
+type DeploymentResource struct {
+	client *client.DokployClient
+}
+
+type DeploymentResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ApplicationID types.String `tfsdk:"application_id"`
+	ComposeID     types.String `tfsdk:"compose_id"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	Status        types.String `tfsdk:"status"`
+	Title         types.String `tfsdk:"title"`
+	LogPath       types.String `tfsdk:"log_path"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	StartedAt     types.String `tfsdk:"started_at"`
+	FinishedAt    types.String `tfsdk:"finished_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DeploymentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (r *DeploymentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Deploys an application or compose stack and waits for the deployment to finish. " +
+			"A new deployment is started whenever the target or any value in triggers changes. " +
+			"Destroying this resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Dokploy deployment ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Application to deploy. Exactly one of application_id or compose_id must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"compose_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Compose stack to deploy. Exactly one of application_id or compose_id must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Arbitrary values that start a new deployment when changed, e.g. an image tag or commit SHA.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Deployment status reported by Dokploy (done, error, ...).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_path": schema.StringAttribute{
+				Computed:    true,
+				Description: "Path of the build log on the Dokploy server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"started_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"finished_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *DeploymentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DeploymentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	serviceType, serviceID, err := getEnvironmentVariableTarget(plan.ApplicationID, plan.ComposeID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid deployment target", err.Error())
+		return
+	}
+
+	deployment, err := deployAndWait(ctx, r.client, serviceType, serviceID, defaultDeploymentPollInterval)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deploying "+serviceType, err.Error())
+		return
+	}
+
+	plan.applyDeployment(deployment)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	if deployment.Status != client.DeploymentStatusDone {
+		// The state is kept so the failed deployment is tainted and retried on
+		// the next apply.
+		resp.Diagnostics.AddError(
+			"Deployment failed",
+			deploymentFailureDetail(ctx, r.client, serviceType, serviceID, deployment),
+		)
+	}
+}
+
+func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DeploymentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	serviceType, serviceID, err := getEnvironmentVariableTarget(state.ApplicationID, state.ComposeID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid deployment target", err.Error())
+		return
+	}
+
+	deployments, err := r.client.ListDeployments(ctx, serviceType, serviceID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading deployments", err.Error())
+		return
+	}
+
+	// Dokploy prunes old deployments. A deployment that is no longer listed
+	// still happened, so keep the last known state instead of redeploying.
+	for i := range deployments {
+		if deployments[i].ID == state.ID.ValueString() {
+			state.applyDeployment(&deployments[i])
+			break
+		}
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so there is nothing to
+	// send to Dokploy; carry the computed values over.
+	var plan DeploymentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DeploymentResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Deployments cannot be undone; removing the resource only drops it from state.
+}
+
+func (m *DeploymentResourceModel) applyDeployment(deployment *client.Deployment) {
+	m.ID = types.StringValue(deployment.ID)
+	m.Status = types.StringValue(deployment.Status)
+	m.Title = types.StringValue(deployment.Title)
+	m.LogPath = types.StringValue(deployment.LogPath)
+	m.CreatedAt = types.StringValue(deployment.CreatedAt)
+	m.StartedAt = types.StringValue(deployment.StartedAt)
+	m.FinishedAt = types.StringValue(deployment.FinishedAt)
+}
+
+// deployAndWait starts a deployment and blocks until Dokploy reports it as
+// finished. Deployments that existed before the call are ignored so an older
+// run is never mistaken for the new one.
+func deployAndWait(ctx context.Context, c *client.DokployClient, serviceType, serviceID string, pollInterval time.Duration) (*client.Deployment, error) {
+	existing, err := c.ListDeployments(ctx, serviceType, serviceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list existing deployments: %w", err)
+	}
+	known := make(map[string]bool, len(existing))
+	for _, deployment := range existing {
+		known[deployment.ID] = true
+	}
+
+	if err := c.Deploy(ctx, serviceType, serviceID); err != nil {
+		return nil, err
+	}
+	tflog.Debug(ctx, "Deployment queued, waiting for it to finish", map[string]interface{}{
+		"service_type": serviceType,
+		"service_id":   serviceID,
+	})
+
+	return c.WaitForNewDeployment(ctx, serviceType, serviceID, known, pollInterval)
+}
+
+// deploymentFailureDetail describes a failed deployment, including the tail
+// of its build log when Dokploy lets us read it.
+func deploymentFailureDetail(ctx context.Context, c *client.DokployClient, serviceType, serviceID string, deployment *client.Deployment) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Deployment %s of %s %s finished with status %q.", deployment.ID, serviceType, serviceID, deployment.Status)
+	if deployment.ErrorMessage != "" {
+		fmt.Fprintf(&b, "\n\n%s", deployment.ErrorMessage)
+	}
+
+	if deployment.LogPath == "" {
+		return b.String()
+	}
+
+	lines, err := c.ReadDeploymentLogTail(ctx, deployment.LogPath, deploymentLogTailLines)
+	switch {
+	case err != nil:
+		tflog.Warn(ctx, "Unable to read deployment log", map[string]interface{}{"error": err.Error()})
+		fmt.Fprintf(&b, "\n\nThe build log could not be read (%s); it is stored on the Dokploy server at %s.", err, deployment.LogPath)
+	case len(lines) > 0:
+		fmt.Fprintf(&b, "\n\nLast %d lines of the build log:\n\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+	return b.String()
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +18,7 @@ import (
 
 var _ resource.Resource = &DomainResource{}
 var _ resource.ResourceWithImportState = &DomainResource{}
+var _ resource.ResourceWithValidateConfig = &DomainResource{}
 
 func NewDomainResource() resource.Resource {
 	return &DomainResource{}
@@ -35,15 +38,19 @@ type DomainResourceModel struct {
 	Port                types.Int64  `tfsdk:"port"`
 	HTTPS               types.Bool   `tfsdk:"https"`
 	CertificateProvider types.String `tfsdk:"certificate_provider"`
+	CustomCertResolver  types.String `tfsdk:"custom_cert_resolver"`
+	CertificateID       types.String `tfsdk:"certificate_id"`
 	GenerateTraefikMe   types.Bool   `tfsdk:"generate_traefik_me"`
 	RedeployOnUpdate    types.Bool   `tfsdk:"redeploy_on_update"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *DomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_domain"
 }
 
-func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DomainResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -93,9 +100,19 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed:    true,
 				Description: "Certificate provider for the domain. Supported values: letsencrypt, none, custom.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					domainCertificateProviderModifier{},
 				},
 			},
+			"custom_cert_resolver": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the Traefik certificate resolver to use. Requires certificate_provider = \"custom\".",
+			},
+			"certificate_id": schema.StringAttribute{
+				Optional: true,
+				Description: "ID of a dokploy_certificate that covers host. Dokploy has no per-domain certificate setting: " +
+					"Traefik picks the uploaded certificate by host name, and this attribute checks on apply that the certificate exists and covers host. " +
+					"Requires https and defaults certificate_provider to none, so Let's Encrypt does not replace it.",
+			},
 			"generate_traefik_me": schema.BoolAttribute{
 				Optional:    true,
 				Description: "If true, generates a traefik.me domain for the application.",
@@ -105,6 +122,9 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "If true, triggers a redeploy of the associated application or compose stack when the domain is created or updated.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -120,6 +140,17 @@ func (r *DomainResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+func (r *DomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DomainResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDomainCertificate(config)...)
+}
+
 func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan DomainResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -128,6 +159,14 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	if plan.ApplicationID.IsNull() && plan.ComposeID.IsNull() {
 		resp.Diagnostics.AddError("Missing Association", "Either application_id or compose_id must be provided")
 		return
@@ -137,14 +176,14 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 	if !plan.GenerateTraefikMe.IsNull() && plan.GenerateTraefikMe.ValueBool() {
 		var name string
 		if !plan.ApplicationID.IsNull() {
-			app, err := r.client.GetApplication(plan.ApplicationID.ValueString())
+			app, err := r.client.GetApplication(ctx, plan.ApplicationID.ValueString())
 			if err != nil {
 				resp.Diagnostics.AddError("Error fetching application for domain generation", err.Error())
 				return
 			}
 			name = app.Name
 		} else {
-			comp, err := r.client.GetCompose(plan.ComposeID.ValueString())
+			comp, err := r.client.GetCompose(ctx, plan.ComposeID.ValueString())
 			if err != nil {
 				resp.Diagnostics.AddError("Error fetching compose for domain generation", err.Error())
 				return
@@ -152,7 +191,7 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 			name = comp.Name
 		}
 
-		generatedDomain, err := r.client.GenerateDomain(name)
+		generatedDomain, err := r.client.GenerateDomain(ctx, name)
 		if err != nil {
 			resp.Diagnostics.AddError("Error generating traefik.me domain", err.Error())
 			return
@@ -176,13 +215,17 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		plan.HTTPS = types.BoolValue(true)
 	}
 	if plan.CertificateProvider.IsUnknown() || plan.CertificateProvider.IsNull() || plan.CertificateProvider.ValueString() == "" {
-		plan.CertificateProvider = types.StringValue(defaultCertificateProvider(plan.HTTPS.ValueBool()))
+		plan.CertificateProvider = types.StringValue(domainCertificateProvider(plan))
 	}
 	certificateType, err := certificateTypeFromPlan(plan.HTTPS.ValueBool(), plan.CertificateProvider.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid certificate_provider", err.Error())
 		return
 	}
+	if err := r.checkCertificate(ctx, plan); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("certificate_id"), "Invalid Domain Certificate", err.Error())
+		return
+	}
 
 	domain := client.Domain{
 		ApplicationID:   plan.ApplicationID.ValueString(),
@@ -193,9 +236,11 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		Port:            plan.Port.ValueInt64(),
 		HTTPS:           plan.HTTPS.ValueBool(),
 		CertificateType: certificateType,
+
+		CustomCertResolver: plan.CustomCertResolver.ValueString(),
 	}
 
-	createdDomain, err := r.client.CreateDomain(domain)
+	createdDomain, err := r.client.CreateDomain(ctx, domain)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating domain", err.Error())
 		return
@@ -207,9 +252,9 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Trigger Redeploy if requested
 	if !plan.RedeployOnUpdate.IsNull() && plan.RedeployOnUpdate.ValueBool() {
 		if !plan.ApplicationID.IsNull() {
-			_ = r.client.DeployApplication(plan.ApplicationID.ValueString())
+			_ = r.client.DeployApplication(ctx, plan.ApplicationID.ValueString())
 		} else if !plan.ComposeID.IsNull() {
-			_ = r.client.DeployCompose(plan.ComposeID.ValueString())
+			_ = r.client.DeployCompose(ctx, plan.ComposeID.ValueString())
 		}
 	}
 
@@ -225,16 +270,24 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	var domains []client.Domain
 	var err error
 	if !state.ApplicationID.IsNull() {
-		domains, err = r.client.GetDomainsByApplication(state.ApplicationID.ValueString())
+		domains, err = r.client.GetDomainsByApplication(ctx, state.ApplicationID.ValueString())
 	} else {
-		domains, err = r.client.GetDomainsByCompose(state.ComposeID.ValueString())
+		domains, err = r.client.GetDomainsByCompose(ctx, state.ComposeID.ValueString())
 	}
 
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -254,6 +307,10 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 			} else {
 				state.CertificateProvider = types.StringValue(defaultCertificateProvider(d.HTTPS))
 			}
+			state.CustomCertResolver = types.StringNull()
+			if d.CustomCertResolver != "" {
+				state.CustomCertResolver = types.StringValue(d.CustomCertResolver)
+			}
 			state.ServiceName = types.StringValue(d.ServiceName)
 			if d.ApplicationID != "" {
 				state.ApplicationID = types.StringValue(d.ApplicationID)
@@ -271,6 +328,17 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	// A deleted certificate shows up as drift on certificate_id.
+	if certificateID, ok := configuredString(state.CertificateID); ok {
+		if _, err := r.client.GetCertificate(ctx, certificateID); err != nil {
+			if !client.IsNotFound(err) {
+				resp.Diagnostics.AddError("Error reading domain certificate", err.Error())
+				return
+			}
+			state.CertificateID = types.StringNull()
+		}
+	}
+
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -282,17 +350,29 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 	if plan.HTTPS.IsUnknown() || plan.HTTPS.IsNull() {
 		plan.HTTPS = types.BoolValue(true)
 	}
 	if plan.CertificateProvider.IsUnknown() || plan.CertificateProvider.IsNull() || plan.CertificateProvider.ValueString() == "" {
-		plan.CertificateProvider = types.StringValue(defaultCertificateProvider(plan.HTTPS.ValueBool()))
+		plan.CertificateProvider = types.StringValue(domainCertificateProvider(plan))
 	}
 	certificateType, err := certificateTypeFromPlan(plan.HTTPS.ValueBool(), plan.CertificateProvider.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid certificate_provider", err.Error())
 		return
 	}
+	if err := r.checkCertificate(ctx, plan); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("certificate_id"), "Invalid Domain Certificate", err.Error())
+		return
+	}
 
 	domain := client.Domain{
 		ID:              plan.ID.ValueString(),
@@ -304,9 +384,11 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Port:            plan.Port.ValueInt64(),
 		HTTPS:           plan.HTTPS.ValueBool(),
 		CertificateType: certificateType,
+
+		CustomCertResolver: plan.CustomCertResolver.ValueString(),
 	}
 
-	updatedDomain, err := r.client.UpdateDomain(domain)
+	updatedDomain, err := r.client.UpdateDomain(ctx, domain)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating domain", err.Error())
 		return
@@ -326,9 +408,9 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Trigger Redeploy if requested
 	if !plan.RedeployOnUpdate.IsNull() && plan.RedeployOnUpdate.ValueBool() {
 		if !plan.ApplicationID.IsNull() {
-			_ = r.client.DeployApplication(plan.ApplicationID.ValueString())
+			_ = r.client.DeployApplication(ctx, plan.ApplicationID.ValueString())
 		} else if !plan.ComposeID.IsNull() {
-			_ = r.client.DeployCompose(plan.ComposeID.ValueString())
+			_ = r.client.DeployCompose(ctx, plan.ComposeID.ValueString())
 		}
 	}
 
@@ -344,9 +426,17 @@ func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteDomain(state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteDomain(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError("Error deleting domain", err.Error())
@@ -365,6 +455,133 @@ func defaultCertificateProvider(httpsEnabled bool) string {
 	return "none"
 }
 
+// domainCertificateProvider returns the certificate provider of a domain that
+// does not set one. Uploaded certificates are served by Traefik without a
+// resolver, and a custom resolver implies the custom provider.
+func domainCertificateProvider(plan DomainResourceModel) string {
+	if _, ok := configuredString(plan.CertificateID); ok {
+		return "none"
+	}
+	if _, ok := configuredString(plan.CustomCertResolver); ok {
+		return "custom"
+	}
+	return defaultCertificateProvider(plan.HTTPS.ValueBool())
+}
+
+// checkCertificate verifies that the certificate referenced by certificate_id
+// exists and covers the host of the domain.
+func (r *DomainResource) checkCertificate(ctx context.Context, plan DomainResourceModel) error {
+	certificateID, ok := configuredString(plan.CertificateID)
+	if !ok {
+		return nil
+	}
+
+	certificate, err := r.client.GetCertificate(ctx, certificateID)
+	if err != nil {
+		return fmt.Errorf("failed to read certificate %s: %w", certificateID, err)
+	}
+	return certificateCoversHost(certificate.CertificateData, plan.Host.ValueString())
+}
+
+// certificateCoversHost checks that the leaf certificate of a PEM chain is
+// valid for host, including wildcard names.
+func certificateCoversHost(certificateData, host string) error {
+	leaf := parseLeafCertificate(certificateData)
+	if leaf == nil {
+		return fmt.Errorf("certificate data returned by Dokploy could not be parsed")
+	}
+	if err := leaf.VerifyHostname(host); err != nil {
+		return fmt.Errorf("certificate does not cover host %q: %w", host, err)
+	}
+	return nil
+}
+
+// domainCertificateProviderModifier keeps the certificate provider from state
+// like UseStateForUnknown, but follows certificate_id and
+// custom_cert_resolver when they are added to or removed from a domain.
+type domainCertificateProviderModifier struct{}
+
+func (m domainCertificateProviderModifier) Description(_ context.Context) string {
+	return "Defaults to none with certificate_id, custom with custom_cert_resolver, and the prior state otherwise."
+}
+
+func (m domainCertificateProviderModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m domainCertificateProviderModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var certificateID, resolver types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("certificate_id"), &certificateID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("custom_cert_resolver"), &resolver)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case certificateID.IsUnknown() || resolver.IsUnknown():
+		resp.PlanValue = types.StringUnknown()
+	case !certificateID.IsNull():
+		resp.PlanValue = types.StringValue("none")
+	case !resolver.IsNull():
+		resp.PlanValue = types.StringValue("custom")
+	case !req.StateValue.IsNull():
+		// Removing certificate_id or custom_cert_resolver falls back to the
+		// default provider instead of the one they implied.
+		var priorCertificateID, priorResolver types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("certificate_id"), &priorCertificateID)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("custom_cert_resolver"), &priorResolver)...)
+		if !priorCertificateID.IsNull() || !priorResolver.IsNull() {
+			resp.PlanValue = types.StringUnknown()
+			return
+		}
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// validateDomainCertificate checks that custom_cert_resolver and
+// certificate_id are combined with a matching certificate provider. Unknown
+// values are skipped.
+func validateDomainCertificate(config DomainResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	httpsDisabled := !config.HTTPS.IsNull() && !config.HTTPS.IsUnknown() && !config.HTTPS.ValueBool()
+	provider, hasProvider := configuredString(config.CertificateProvider)
+	provider = strings.ToLower(strings.TrimSpace(provider))
+	_, hasResolver := configuredString(config.CustomCertResolver)
+	_, hasCertificate := configuredString(config.CertificateID)
+
+	if hasResolver && hasCertificate {
+		diags.AddAttributeError(path.Root("certificate_id"), "Invalid Domain Certificate",
+			"Only one of custom_cert_resolver and certificate_id can be set.")
+		return diags
+	}
+	if hasResolver {
+		if httpsDisabled {
+			diags.AddAttributeError(path.Root("custom_cert_resolver"), "Invalid Domain Certificate",
+				"custom_cert_resolver requires https to be enabled.")
+		}
+		if hasProvider && provider != "custom" {
+			diags.AddAttributeError(path.Root("custom_cert_resolver"), "Invalid Domain Certificate",
+				fmt.Sprintf("custom_cert_resolver requires certificate_provider = \"custom\", got %q.", provider))
+		}
+	}
+	if hasCertificate {
+		if httpsDisabled {
+			diags.AddAttributeError(path.Root("certificate_id"), "Invalid Domain Certificate",
+				"certificate_id requires https to be enabled.")
+		}
+		if hasProvider && provider != "none" {
+			diags.AddAttributeError(path.Root("certificate_id"), "Invalid Domain Certificate",
+				fmt.Sprintf("certificate_id requires certificate_provider = \"none\", got %q.", provider))
+		}
+	}
+	return diags
+}
+
 func certificateTypeFromPlan(httpsEnabled bool, provider string) (string, error) {
 	normalizedProvider := strings.ToLower(strings.TrimSpace(provider))
 	if normalizedProvider == "" {
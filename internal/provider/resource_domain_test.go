@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testDomainModel() DomainResourceModel {
+	return DomainResourceModel{
+		HTTPS:               types.BoolNull(),
+		CertificateProvider: types.StringNull(),
+		CustomCertResolver:  types.StringNull(),
+		CertificateID:       types.StringNull(),
+	}
+}
+
+func TestValidateDomainCertificate(t *testing.T) {
+	resolver := testDomainModel()
+	resolver.CertificateProvider = types.StringValue("custom")
+	resolver.CustomCertResolver = types.StringValue("cloudflare")
+
+	resolverDefaultProvider := testDomainModel()
+	resolverDefaultProvider.CustomCertResolver = types.StringValue("cloudflare")
+
+	resolverWithLetsEncrypt := resolver
+	resolverWithLetsEncrypt.CertificateProvider = types.StringValue("letsencrypt")
+
+	certificate := testDomainModel()
+	certificate.CertificateID = types.StringValue("cert-1")
+
+	certificateWithoutHTTPS := certificate
+	certificateWithoutHTTPS.HTTPS = types.BoolValue(false)
+
+	certificateWithCustom := certificate
+	certificateWithCustom.CertificateProvider = types.StringValue("custom")
+
+	both := resolver
+	both.CertificateID = types.StringValue("cert-1")
+
+	unknownCertificate := testDomainModel()
+	unknownCertificate.CertificateID = types.StringUnknown()
+	unknownCertificate.HTTPS = types.BoolValue(false)
+
+	tests := []struct {
+		name   string
+		config DomainResourceModel
+		errors int
+	}{
+		{"none", testDomainModel(), 0},
+		{"resolver", resolver, 0},
+		{"resolver with default provider", resolverDefaultProvider, 0},
+		{"resolver with letsencrypt", resolverWithLetsEncrypt, 1},
+		{"certificate", certificate, 0},
+		{"certificate without https", certificateWithoutHTTPS, 1},
+		{"certificate with custom provider", certificateWithCustom, 1},
+		{"resolver and certificate", both, 1},
+		{"unknown certificate", unknownCertificate, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateDomainCertificate(tc.config)
+			if diags.ErrorsCount() != tc.errors {
+				t.Fatalf("expected %d errors, got %v", tc.errors, diags)
+			}
+		})
+	}
+}
+
+func TestDomainCertificateProvider(t *testing.T) {
+	plan := testDomainModel()
+	plan.HTTPS = types.BoolValue(true)
+	if got := domainCertificateProvider(plan); got != "letsencrypt" {
+		t.Fatalf("expected letsencrypt, got %q", got)
+	}
+
+	plan.CustomCertResolver = types.StringValue("cloudflare")
+	if got := domainCertificateProvider(plan); got != "custom" {
+		t.Fatalf("expected custom, got %q", got)
+	}
+
+	plan.CustomCertResolver = types.StringNull()
+	plan.CertificateID = types.StringValue("cert-1")
+	if got := domainCertificateProvider(plan); got != "none" {
+		t.Fatalf("expected none, got %q", got)
+	}
+}
+
+func TestCertificateCoversHost(t *testing.T) {
+	now := time.Now()
+	certificateData, _ := testCertificatePEM(t, now.AddDate(0, -1, 0), now.AddDate(0, 1, 0))
+
+	if err := certificateCoversHost(certificateData, "example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := certificateCoversHost(certificateData, "api.example.com"); err == nil {
+		t.Fatal("expected an error for a host the certificate does not cover")
+	}
+	if err := certificateCoversHost("certificate", "example.com"); err == nil {
+		t.Fatal("expected an error for unparsable certificate data")
+	}
+}
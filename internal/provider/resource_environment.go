@@ -7,6 +7,7 @@ import (
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -32,13 +33,15 @@ type EnvironmentResourceModel struct {
 	ProjectID   types.String `tfsdk:"project_id"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *EnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_environment"
 }
 
-func (r *EnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *EnvironmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -61,6 +64,9 @@ func (r *EnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -84,11 +90,19 @@ func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	env, err := r.client.CreateEnvironment(plan.ProjectID.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	env, err := r.client.CreateEnvironment(ctx, plan.ProjectID.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
 	if err != nil {
 		// Dokploy may reject creation for reserved names (e.g. "production") while
 		// still exposing that environment in the project. Recover by resolving by name.
-		existingEnv, lookupErr := r.findProjectEnvironmentByName(plan.ProjectID.ValueString(), plan.Name.ValueString())
+		existingEnv, lookupErr := r.findProjectEnvironmentByName(ctx, plan.ProjectID.ValueString(), plan.Name.ValueString())
 		if lookupErr != nil {
 			resp.Diagnostics.AddError("Error creating environment (and failed to fetch project for recovery)", fmt.Sprintf("Create error: %s. Fetch error: %s", err, lookupErr))
 			return
@@ -118,9 +132,21 @@ func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Environments are read via Project
-	project, err := r.client.GetProject(state.ProjectID.ValueString())
+	project, err := r.client.GetProject(ctx, state.ProjectID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Error reading parent project", err.Error())
 		return
 	}
@@ -154,6 +180,14 @@ func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	env := client.Environment{
 		ID:          plan.ID.ValueString(),
 		Name:        plan.Name.ValueString(),
@@ -161,7 +195,7 @@ func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateReq
 		ProjectID:   plan.ProjectID.ValueString(),
 	}
 
-	updatedEnv, err := r.client.UpdateEnvironment(env)
+	updatedEnv, err := r.client.UpdateEnvironment(ctx, env)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating environment", err.Error())
 		return
@@ -183,8 +217,19 @@ func (r *EnvironmentResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := r.client.DeleteEnvironment(state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteEnvironment(ctx, state.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
 		if isDefaultEnvironmentDeleteError(err) {
 			resp.Diagnostics.AddWarning(
 				"Default Environment Not Deleted",
@@ -212,8 +257,8 @@ func lowercaseFirstRune(s string) string {
 	return string(unicode.ToLower(r)) + s[size:]
 }
 
-func (r *EnvironmentResource) findProjectEnvironmentByName(projectID, envName string) (*client.Environment, error) {
-	project, err := r.client.GetProject(projectID)
+func (r *EnvironmentResource) findProjectEnvironmentByName(ctx context.Context, projectID, envName string) (*client.Environment, error) {
+	project, err := r.client.GetProject(ctx, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ resource.Resource = &EnvironmentEnvironmentVariablesResource{}
+var _ resource.ResourceWithImportState = &EnvironmentEnvironmentVariablesResource{}
+var _ resource.ResourceWithValidateConfig = &EnvironmentEnvironmentVariablesResource{}
+
+// environmentEnvTarget manages the variables shared by the services of an
+// environment.
+var environmentEnvTarget = envTarget{
+	typeName:      "_environment_environment_variables",
+	description:   "Manages the environment variables shared by the services of a Dokploy environment. Services reference them with ${{environment.KEY}}.",
+	idAttribute:   "environment_id",
+	targetName:    "environment",
+	variablesName: "environment shared variables",
+	readEnv: func(c *client.DokployClient, ctx context.Context, id string) (string, error) {
+		environment, err := c.GetEnvironment(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return environment.Env, nil
+	},
+	updateEnv: (*client.DokployClient).UpdateEnvironmentEnv,
+}
+
+func NewEnvironmentEnvironmentVariablesResource() resource.Resource {
+	return &EnvironmentEnvironmentVariablesResource{target: environmentEnvTarget}
+}
+
+type EnvironmentEnvironmentVariablesResource = envTargetVariablesResource[EnvironmentEnvironmentVariablesResourceModel, *EnvironmentEnvironmentVariablesResourceModel]
+
+type EnvironmentEnvironmentVariablesResourceModel struct {
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	envTargetVariablesModel
+}
+
+func (m *EnvironmentEnvironmentVariablesResourceModel) targetID() types.String {
+	return m.EnvironmentID
+}
+
+func (m *EnvironmentEnvironmentVariablesResourceModel) variables() *envTargetVariablesModel {
+	return &m.envTargetVariablesModel
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +16,7 @@ import (
 
 var _ resource.Resource = &EnvironmentVariablesResource{}
 var _ resource.ResourceWithImportState = &EnvironmentVariablesResource{}
+var _ resource.ResourceWithValidateConfig = &EnvironmentVariablesResource{}
 
 func NewEnvironmentVariablesResource() resource.Resource {
 	return &EnvironmentVariablesResource{}
@@ -30,13 +32,19 @@ type EnvironmentVariablesResourceModel struct {
 	ComposeID     types.String `tfsdk:"compose_id"`
 	Variables     types.Map    `tfsdk:"variables"`
 	CreateEnvFile types.Bool   `tfsdk:"create_env_file"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+
+	VariablesWO        types.Map   `tfsdk:"variables_wo"`
+	VariablesWOVersion types.Int64 `tfsdk:"variables_wo_version"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *EnvironmentVariablesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_environment_variables"
 }
 
-func (r *EnvironmentVariablesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *EnvironmentVariablesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages all environment variables for a Dokploy application or compose stack as a single resource.",
 		Attributes: map[string]schema.Attribute{
@@ -50,15 +58,27 @@ func (r *EnvironmentVariablesResource) Schema(_ context.Context, _ resource.Sche
 				Optional: true,
 			},
 			"variables": schema.MapAttribute{
-				Required:    true,
+				Optional:    true,
 				ElementType: types.StringType,
 				Sensitive:   true,
+				Description: "Variables to set. Exactly one of variables and variables_wo must be set.",
 			},
+			"variables_wo":         writeOnlyMapAttribute("variables"),
+			"variables_wo_version": writeOnlyVersionAttribute("variables"),
 			"create_env_file": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
 				Default:  booldefault.StaticBool(true),
 			},
+			"authoritative": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: authoritativeEnvDescription,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
 		},
 	}
 }
@@ -75,6 +95,17 @@ func (r *EnvironmentVariablesResource) Configure(_ context.Context, req resource
 	r.client = client
 }
 
+func (r *EnvironmentVariablesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config EnvironmentVariablesResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateWriteOnlyPair(config.Variables, config.VariablesWO, config.VariablesWOVersion, "variables", true)...)
+}
+
 func (r *EnvironmentVariablesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan EnvironmentVariablesResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -83,8 +114,15 @@ func (r *EnvironmentVariablesResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	envMap := make(map[string]string)
-	diags = plan.Variables.ElementsAs(ctx, &envMap, false)
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	envMap, diags := desiredEnvVariables(ctx, req.Config, plan.Variables)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -96,16 +134,12 @@ func (r *EnvironmentVariablesResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	updateFn := func(m map[string]string) {
-		for k, v := range envMap {
-			m[k] = v
-		}
-	}
+	updateFn := applyEnvVariables(envMap, nil, plan.Authoritative.ValueBool())
 
 	if targetType == "application" {
-		err = r.client.UpdateApplicationEnv(targetID, updateFn, plan.CreateEnvFile.ValueBoolPointer())
+		err = r.client.UpdateApplicationEnv(ctx, targetID, updateFn, plan.CreateEnvFile.ValueBoolPointer())
 	} else {
-		err = r.client.UpdateComposeEnv(targetID, updateFn, plan.CreateEnvFile.ValueBoolPointer())
+		err = r.client.UpdateComposeEnv(ctx, targetID, updateFn, plan.CreateEnvFile.ValueBoolPointer())
 	}
 
 	if err != nil {
@@ -114,6 +148,7 @@ func (r *EnvironmentVariablesResource) Create(ctx context.Context, req resource.
 	}
 
 	plan.ID = types.StringValue(targetID)
+	resp.Diagnostics.Append(writeOwnedEnvKeys(ctx, resp.Private, envKeys(envMap))...)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -127,6 +162,14 @@ func (r *EnvironmentVariablesResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	targetType, targetID, err := getEnvironmentVariableTarget(state.ApplicationID, state.ComposeID)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid Association", err.Error())
@@ -135,9 +178,9 @@ func (r *EnvironmentVariablesResource) Read(ctx context.Context, req resource.Re
 
 	var envMap map[string]string
 	if targetType == "application" {
-		app, appErr := r.client.GetApplication(targetID)
+		app, appErr := r.client.GetApplication(ctx, targetID)
 		if appErr != nil {
-			if strings.Contains(appErr.Error(), "Not Found") || strings.Contains(appErr.Error(), "404") {
+			if client.IsNotFound(appErr) {
 				resp.State.RemoveResource(ctx)
 				return
 			}
@@ -146,9 +189,9 @@ func (r *EnvironmentVariablesResource) Read(ctx context.Context, req resource.Re
 		}
 		envMap = client.ParseEnv(app.Env)
 	} else {
-		comp, compErr := r.client.GetCompose(targetID)
+		comp, compErr := r.client.GetCompose(ctx, targetID)
 		if compErr != nil {
-			if strings.Contains(compErr.Error(), "Not Found") || strings.Contains(compErr.Error(), "404") {
+			if client.IsNotFound(compErr) {
 				resp.State.RemoveResource(ctx)
 				return
 			}
@@ -158,9 +201,29 @@ func (r *EnvironmentVariablesResource) Read(ctx context.Context, req resource.Re
 		envMap = client.ParseEnv(comp.Env)
 	}
 
-	state.ID = types.StringValue(targetID)
-	state.Variables, diags = types.MapValueFrom(ctx, types.StringType, envMap)
+	writeOnly := envVariablesWriteOnly(state.Variables, state.Authoritative)
+	if state.Authoritative.IsNull() {
+		state.Authoritative = types.BoolValue(true)
+	}
+	owned, diags := readOwnedEnvKeys(ctx, req.Private)
 	resp.Diagnostics.Append(diags...)
+	if owned == nil {
+		owned, diags = ownedEnvKeysFallback(ctx, state.Variables)
+		resp.Diagnostics.Append(diags...)
+		if owned == nil {
+			owned = envKeys(envMap)
+		}
+		resp.Diagnostics.Append(writeOwnedEnvKeys(ctx, resp.Private, owned)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(targetID)
+	if !writeOnly {
+		state.Variables, diags = types.MapValueFrom(ctx, types.StringType, managedEnvVariables(envMap, owned, state.Authoritative.ValueBool()))
+		resp.Diagnostics.Append(diags...)
+	}
 
 	// The CreateEnvFile attribute is not stored in the API, so we keep the configured value.
 	// If it's not configured, Terraform will use the default.
@@ -170,15 +233,24 @@ func (r *EnvironmentVariablesResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *EnvironmentVariablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan EnvironmentVariablesResourceModel
+	var plan, state EnvironmentVariablesResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	envMap := make(map[string]string)
-	diags = plan.Variables.ElementsAs(ctx, &envMap, false)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	envMap, diags := desiredEnvVariables(ctx, req.Config, plan.Variables)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -190,20 +262,21 @@ func (r *EnvironmentVariablesResource) Update(ctx context.Context, req resource.
 		return
 	}
 
-	updateFn := func(m map[string]string) {
-		// Clear existing vars and set new ones
-		for k := range m {
-			delete(m, k)
-		}
-		for k, v := range envMap {
-			m[k] = v
-		}
+	owned, diags := readOwnedEnvKeys(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if owned == nil {
+		owned, diags = ownedEnvKeysFallback(ctx, state.Variables)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	updateFn := applyEnvVariables(envMap, owned, plan.Authoritative.ValueBool())
 
 	if targetType == "application" {
-		err = r.client.UpdateApplicationEnv(targetID, updateFn, plan.CreateEnvFile.ValueBoolPointer())
+		err = r.client.UpdateApplicationEnv(ctx, targetID, updateFn, plan.CreateEnvFile.ValueBoolPointer())
 	} else {
-		err = r.client.UpdateComposeEnv(targetID, updateFn, plan.CreateEnvFile.ValueBoolPointer())
+		err = r.client.UpdateComposeEnv(ctx, targetID, updateFn, plan.CreateEnvFile.ValueBoolPointer())
 	}
 
 	if err != nil {
@@ -212,6 +285,7 @@ func (r *EnvironmentVariablesResource) Update(ctx context.Context, req resource.
 	}
 
 	plan.ID = types.StringValue(targetID)
+	resp.Diagnostics.Append(writeOwnedEnvKeys(ctx, resp.Private, envKeys(envMap))...)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -225,26 +299,39 @@ func (r *EnvironmentVariablesResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	targetType, targetID, err := getEnvironmentVariableTarget(state.ApplicationID, state.ComposeID)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid Association", err.Error())
 		return
 	}
 
-	clearFn := func(m map[string]string) {
-		for k := range m {
-			delete(m, k)
-		}
+	owned, diags := readOwnedEnvKeys(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if owned == nil {
+		owned, diags = ownedEnvKeysFallback(ctx, state.Variables)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	clearFn := removeEnvVariables(owned, state.Authoritative.IsNull() || state.Authoritative.ValueBool())
 
 	if targetType == "application" {
-		err = r.client.UpdateApplicationEnv(targetID, clearFn, state.CreateEnvFile.ValueBoolPointer())
+		err = r.client.UpdateApplicationEnv(ctx, targetID, clearFn, state.CreateEnvFile.ValueBoolPointer())
 	} else {
-		err = r.client.UpdateComposeEnv(targetID, clearFn, state.CreateEnvFile.ValueBoolPointer())
+		err = r.client.UpdateComposeEnv(ctx, targetID, clearFn, state.CreateEnvFile.ValueBoolPointer())
 	}
 
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError("Error deleting environment variables", err.Error())
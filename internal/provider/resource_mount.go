@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ resource.Resource = &MountResource{}
+var _ resource.ResourceWithImportState = &MountResource{}
+var _ resource.ResourceWithValidateConfig = &MountResource{}
+
+func NewMountResource() resource.Resource {
+	return &MountResource{}
+}
+
+type MountResource struct {
+	client *client.DokployClient
+}
+
+type MountResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ServiceType types.String `tfsdk:"service_type"`
+	ServiceID   types.String `tfsdk:"service_id"`
+	MountType   types.String `tfsdk:"mount_type"`
+	MountPath   types.String `tfsdk:"mount_path"`
+	HostPath    types.String `tfsdk:"host_path"`
+	VolumeName  types.String `tfsdk:"volume_name"`
+	Content     types.String `tfsdk:"content"`
+	FilePath    types.String `tfsdk:"file_path"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// mountTypeFields lists the source attributes each mount type requires. The
+// attributes of the other mount types must not be set.
+var mountTypeFields = map[string][]string{
+	"bind":   {"host_path"},
+	"volume": {"volume_name"},
+	"file":   {"content", "file_path"},
+}
+
+func (r *MountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mount"
+}
+
+func (r *MountResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a mount of an application, compose stack or database in Dokploy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of the service the mount belongs to: application, compose, postgres, mysql, mariadb, mongo or redis.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the application, compose stack or database.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mount_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Mount type: bind, volume or file.",
+			},
+			"mount_path": schema.StringAttribute{
+				Required:    true,
+				Description: "Path inside the container.",
+			},
+			"host_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path on the host. Required for bind mounts.",
+			},
+			"volume_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the Docker volume. Required for volume mounts.",
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Content of the file. Required for file mounts.",
+			},
+			"file_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the file Dokploy stores the content in, relative to the files directory of the service. Required for file mounts.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *MountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *MountResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config MountResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateMount(config)...)
+}
+
+func (r *MountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan MountResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	mount := mountFromPlan(plan)
+	mount.ServiceType = plan.ServiceType.ValueString()
+	mount.ServiceID = plan.ServiceID.ValueString()
+
+	created, err := r.client.CreateMount(ctx, mount)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating mount", err.Error())
+		return
+	}
+	if created.ID == "" {
+		resp.Diagnostics.AddError(
+			"Error creating mount",
+			fmt.Sprintf("Dokploy did not return the ID of the mount created on %s %s.", mount.ServiceType, mount.ServiceID),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *MountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state MountResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	mount, err := r.client.GetMount(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading mount", err.Error())
+		return
+	}
+
+	state.applyMount(mount)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *MountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan MountResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	mount := mountFromPlan(plan)
+	mount.ID = plan.ID.ValueString()
+
+	if err := r.client.UpdateMount(ctx, mount); err != nil {
+		resp.Diagnostics.AddError("Error updating mount", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *MountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state MountResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteMount(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting mount", err.Error())
+		return
+	}
+}
+
+func (r *MountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceType, serviceID, mountID, err := parseMountImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), mountID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_type"), serviceType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+}
+
+// parseMountImportID splits an import ID of the form
+// service_type/service_id/mount_id.
+func parseMountImportID(id string) (string, string, string, error) {
+	parts, err := splitImportID(id, "service_type", "service_id", "mount_id")
+	if err != nil {
+		return "", "", "", err
+	}
+	if !containsString(client.MountServiceTypes, parts[0]) {
+		return "", "", "", fmt.Errorf("service_type must be one of %s, got %q", strings.Join(client.MountServiceTypes, ", "), parts[0])
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// validateMount checks the service type and that exactly the source
+// attributes of the mount type are set. Unknown values are skipped.
+func validateMount(config MountResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !config.ServiceType.IsNull() && !config.ServiceType.IsUnknown() && !containsString(client.MountServiceTypes, config.ServiceType.ValueString()) {
+		diags.AddAttributeError(path.Root("service_type"), "Invalid Mount Configuration",
+			fmt.Sprintf("service_type must be one of %s, got %q.", strings.Join(client.MountServiceTypes, ", "), config.ServiceType.ValueString()))
+	}
+
+	if config.MountType.IsUnknown() {
+		return diags
+	}
+	mountType := config.MountType.ValueString()
+	required, ok := mountTypeFields[mountType]
+	if !ok {
+		diags.AddAttributeError(path.Root("mount_type"), "Invalid Mount Configuration",
+			fmt.Sprintf("mount_type must be one of bind, volume or file, got %q.", mountType))
+		return diags
+	}
+
+	values := map[string]types.String{
+		"host_path":   config.HostPath,
+		"volume_name": config.VolumeName,
+		"content":     config.Content,
+		"file_path":   config.FilePath,
+	}
+	for _, name := range []string{"host_path", "volume_name", "content", "file_path"} {
+		value := values[name]
+		if value.IsUnknown() {
+			continue
+		}
+		if containsString(required, name) {
+			if value.IsNull() {
+				diags.AddAttributeError(path.Root(name), "Invalid Mount Configuration",
+					fmt.Sprintf("%s is required for %s mounts.", name, mountType))
+			}
+			continue
+		}
+		if !value.IsNull() {
+			diags.AddAttributeError(path.Root(name), "Invalid Mount Configuration",
+				fmt.Sprintf("%s can not be set for %s mounts.", name, mountType))
+		}
+	}
+
+	return diags
+}
+
+func mountFromPlan(plan MountResourceModel) client.Mount {
+	return client.Mount{
+		MountType:  plan.MountType.ValueString(),
+		MountPath:  plan.MountPath.ValueString(),
+		HostPath:   plan.HostPath.ValueString(),
+		VolumeName: plan.VolumeName.ValueString(),
+		Content:    plan.Content.ValueString(),
+		FilePath:   plan.FilePath.ValueString(),
+	}
+}
+
+func (m *MountResourceModel) applyMount(mount *client.Mount) {
+	serviceType, serviceID := mount.Target()
+	if serviceType != "" && serviceID != "" {
+		m.ServiceType = types.StringValue(serviceType)
+		m.ServiceID = types.StringValue(serviceID)
+	}
+	m.MountType = types.StringValue(strings.TrimSpace(mount.Type))
+	if m.MountType.ValueString() == "" {
+		m.MountType = types.StringValue(strings.TrimSpace(mount.MountType))
+	}
+	m.MountPath = types.StringValue(mount.MountPath)
+	m.HostPath = mountString(mount.HostPath)
+	// Keep the configured volume name when Dokploy returns it with the app
+	// name of the service as prefix or suffix.
+	if !sameMountVolume(mount.VolumeName, m.VolumeName.ValueString()) {
+		m.VolumeName = mountString(mount.VolumeName)
+	}
+	m.Content = mountString(mount.Content)
+	m.FilePath = mountString(mount.FilePath)
+}
+
+func mountString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func sameMountVolume(actual, configured string) bool {
+	if configured == "" {
+		return false
+	}
+	return actual == configured ||
+		strings.HasSuffix(actual, "_"+configured) ||
+		strings.HasPrefix(actual, configured+"_")
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func testMountModel(mountType string) MountResourceModel {
+	return MountResourceModel{
+		ServiceType: types.StringValue("postgres"),
+		ServiceID:   types.StringValue("pg-1"),
+		MountType:   types.StringValue(mountType),
+		MountPath:   types.StringValue("/etc/app"),
+		HostPath:    types.StringNull(),
+		VolumeName:  types.StringNull(),
+		Content:     types.StringNull(),
+		FilePath:    types.StringNull(),
+	}
+}
+
+func TestValidateMount(t *testing.T) {
+	bind := testMountModel("bind")
+	bind.HostPath = types.StringValue("/srv/data")
+
+	file := testMountModel("file")
+	file.Content = types.StringValue("key=value")
+	file.FilePath = types.StringValue("app.conf")
+
+	fileWithoutPath := testMountModel("file")
+	fileWithoutPath.Content = types.StringValue("key=value")
+
+	volumeWithHostPath := testMountModel("volume")
+	volumeWithHostPath.VolumeName = types.StringValue("data")
+	volumeWithHostPath.HostPath = types.StringValue("/srv/data")
+
+	unknownSource := testMountModel("volume")
+	unknownSource.VolumeName = types.StringUnknown()
+
+	invalidService := bind
+	invalidService.ServiceType = types.StringValue("libsql")
+
+	tests := []struct {
+		name   string
+		config MountResourceModel
+		errors int
+	}{
+		{"bind", bind, 0},
+		{"file", file, 0},
+		{"unknown source", unknownSource, 0},
+		{"file without file_path", fileWithoutPath, 1},
+		{"volume with host_path", volumeWithHostPath, 1},
+		{"invalid mount type", testMountModel("tmpfs"), 1},
+		{"invalid service type", invalidService, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateMount(tt.config)
+			if diags.ErrorsCount() != tt.errors {
+				t.Fatalf("expected %d errors, got %v", tt.errors, diags)
+			}
+		})
+	}
+}
+
+func TestParseMountImportID_ValidatesServiceType(t *testing.T) {
+	serviceType, serviceID, mountID, err := parseMountImportID("compose/comp-1/mount-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if serviceType != "compose" || serviceID != "comp-1" || mountID != "mount-1" {
+		t.Fatalf("unexpected parts: %q %q %q", serviceType, serviceID, mountID)
+	}
+
+	if _, _, _, err := parseMountImportID("libsql/db-1/mount-1"); err == nil {
+		t.Fatal("expected an error for an unsupported service type")
+	}
+}
+
+func TestApplyMount_KeepsPrefixedVolumeName(t *testing.T) {
+	model := testMountModel("volume")
+	model.VolumeName = types.StringValue("data")
+	model.applyMount(&client.Mount{
+		ID:          "mount-1",
+		Type:        "volume",
+		MountPath:   "/var/lib/postgresql/data",
+		VolumeName:  "pg-main_data",
+		ServiceType: "postgres",
+		PostgresID:  "pg-2",
+	})
+
+	if model.VolumeName.ValueString() != "data" {
+		t.Fatalf("volume_name was overwritten: %q", model.VolumeName.ValueString())
+	}
+	if model.ServiceID.ValueString() != "pg-2" || model.MountPath.ValueString() != "/var/lib/postgresql/data" {
+		t.Fatalf("unexpected state: %+v", model)
+	}
+	if !model.HostPath.IsNull() || !model.Content.IsNull() {
+		t.Fatalf("expected unused sources to be null: %+v", model)
+	}
+}
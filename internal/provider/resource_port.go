@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -32,13 +33,15 @@ type PortResourceModel struct {
 	TargetPort    types.Int64  `tfsdk:"target_port"`
 	Protocol      types.String `tfsdk:"protocol"`
 	PublishMode   types.String `tfsdk:"publish_mode"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *PortResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_port"
 }
 
-func (r *PortResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *PortResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages an application port binding in Dokploy.",
 		Attributes: map[string]schema.Attribute{
@@ -71,6 +74,9 @@ func (r *PortResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Port publish mode. Defaults to ingress.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -94,6 +100,14 @@ func (r *PortResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	if plan.Protocol.IsUnknown() || plan.Protocol.IsNull() || strings.TrimSpace(plan.Protocol.ValueString()) == "" {
 		plan.Protocol = types.StringValue("tcp")
 	}
@@ -101,7 +115,7 @@ func (r *PortResource) Create(ctx context.Context, req resource.CreateRequest, r
 		plan.PublishMode = types.StringValue("ingress")
 	}
 
-	createdPort, err := r.client.CreatePort(client.Port{
+	createdPort, err := r.client.CreatePort(ctx, client.Port{
 		ApplicationID: plan.ApplicationID.ValueString(),
 		PublishedPort: plan.PublishedPort.ValueInt64(),
 		TargetPort:    plan.TargetPort.ValueInt64(),
@@ -146,9 +160,17 @@ func (r *PortResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	port, err := r.client.GetPort(state.ID.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	port, err := r.client.GetPort(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -189,6 +211,14 @@ func (r *PortResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	if plan.Protocol.IsUnknown() || plan.Protocol.IsNull() || strings.TrimSpace(plan.Protocol.ValueString()) == "" {
 		plan.Protocol = types.StringValue("tcp")
 	}
@@ -196,7 +226,7 @@ func (r *PortResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		plan.PublishMode = types.StringValue("ingress")
 	}
 
-	updatedPort, err := r.client.UpdatePort(client.Port{
+	updatedPort, err := r.client.UpdatePort(ctx, client.Port{
 		ID:            plan.ID.ValueString(),
 		PublishedPort: plan.PublishedPort.ValueInt64(),
 		TargetPort:    plan.TargetPort.ValueInt64(),
@@ -240,9 +270,17 @@ func (r *PortResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.client.DeletePort(state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeletePort(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError("Error deleting port", err.Error())
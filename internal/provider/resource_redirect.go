@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ resource.Resource = &RedirectResource{}
+var _ resource.ResourceWithImportState = &RedirectResource{}
+var _ resource.ResourceWithValidateConfig = &RedirectResource{}
+
+func NewRedirectResource() resource.Resource {
+	return &RedirectResource{}
+}
+
+type RedirectResource struct {
+	client *client.DokployClient
+}
+
+type RedirectResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ApplicationID types.String `tfsdk:"application_id"`
+	Regex         types.String `tfsdk:"regex"`
+	Replacement   types.String `tfsdk:"replacement"`
+	Permanent     types.Bool   `tfsdk:"permanent"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *RedirectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_redirect"
+}
+
+func (r *RedirectResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a regex redirect rule of a Dokploy application, such as www to apex or legacy path redirects.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"regex": schema.StringAttribute{
+				Required:    true,
+				Description: "Regular expression matched against the full request URL, such as \"^https?://www\\\\.example\\\\.com/(.*)\".",
+			},
+			"replacement": schema.StringAttribute{
+				Required:    true,
+				Description: "URL to redirect to. Capture groups of regex are available as ${1}, ${2} and so on.",
+			},
+			"permanent": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the redirect is permanent (301) instead of temporary (302). Defaults to false.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *RedirectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *RedirectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config RedirectResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Traefik uses Go regular expressions, so the same parser catches
+	// mistakes at plan time.
+	if value, ok := configuredString(config.Regex); ok {
+		if _, err := regexp.Compile(value); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("regex"), "Invalid Redirect Configuration",
+				fmt.Sprintf("regex is not a valid regular expression: %s", err))
+		}
+	}
+}
+
+func (r *RedirectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RedirectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	created, err := r.client.CreateRedirect(ctx, client.Redirect{
+		ApplicationID: plan.ApplicationID.ValueString(),
+		Regex:         plan.Regex.ValueString(),
+		Replacement:   plan.Replacement.ValueString(),
+		Permanent:     plan.Permanent.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating redirect", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RedirectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RedirectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	redirects, err := r.client.ListRedirects(ctx, state.ApplicationID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading redirect", err.Error())
+		return
+	}
+
+	redirect := findRedirect(redirects, state.ID.ValueString())
+	if redirect == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Regex = types.StringValue(redirect.Regex)
+	state.Replacement = types.StringValue(redirect.Replacement)
+	state.Permanent = types.BoolValue(redirect.Permanent)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RedirectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RedirectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := r.client.UpdateRedirect(ctx, client.Redirect{
+		ID:          plan.ID.ValueString(),
+		Regex:       plan.Regex.ValueString(),
+		Replacement: plan.Replacement.ValueString(),
+		Permanent:   plan.Permanent.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating redirect", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RedirectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RedirectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteRedirect(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting redirect", err.Error())
+		return
+	}
+}
+
+func (r *RedirectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportID(req.ID, "application_id", "redirect_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), parts[0])...)
+}
+
+func findRedirect(redirects []client.Redirect, id string) *client.Redirect {
+	for i := range redirects {
+		if redirects[i].ID == id {
+			return &redirects[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testRedirectModel(id string) RedirectResourceModel {
+	return RedirectResourceModel{
+		ID:            types.StringValue(id),
+		ApplicationID: types.StringValue("app-1"),
+		Regex:         types.StringValue("^old$"),
+		Replacement:   types.StringValue("/old"),
+		Permanent:     types.BoolValue(false),
+		Timeouts:      testNullTimeouts(),
+	}
+}
+
+func TestRedirectRead(t *testing.T) {
+	const redirects = `{"applicationId":"app-1","redirects":[
+		{"redirectId":"red-1","regex":"^other$","replacement":"/other","permanent":false},
+		{"redirectId":"red-2","regex":"^www\\.(.*)","replacement":"https://$1","permanent":true}
+	]}`
+
+	tests := []struct {
+		name        string
+		id          string
+		status      int
+		body        string
+		wantRemoved bool
+		wantError   bool
+	}{
+		{name: "matches by ID", id: "red-2", status: http.StatusOK, body: redirects},
+		{name: "missing redirect", id: "red-3", status: http.StatusOK, body: redirects, wantRemoved: true},
+		{name: "deleted application", id: "red-1", status: http.StatusNotFound, body: `{"message":"Application not found","code":"NOT_FOUND"}`, wantRemoved: true},
+		{name: "server error", id: "red-1", status: http.StatusBadRequest, body: `{"message":"invalid input","code":"BAD_REQUEST"}`, wantError: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			resp := testReadResource(t, NewRedirectResource(), server.URL, testRedirectModel(tc.id))
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != tc.wantRemoved {
+				t.Fatalf("expected removed=%v, got state %v", tc.wantRemoved, resp.State.Raw)
+			}
+			if tc.wantRemoved || tc.wantError {
+				return
+			}
+
+			var state RedirectResourceModel
+			if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+				t.Fatalf("reading state: %v", diags)
+			}
+			if state.ID.ValueString() != tc.id || state.Regex.ValueString() != `^www\.(.*)` ||
+				state.Replacement.ValueString() != "https://$1" || !state.Permanent.ValueBool() {
+				t.Fatalf("unexpected state: %+v", state)
+			}
+		})
+	}
+}
+
+func TestRedirectCreate_ResolvesRedirectAfterBooleanResponse(t *testing.T) {
+	var payload map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/redirects.create":
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`true`))
+		case "/application.one":
+			_, _ = w.Write([]byte(`{"applicationId":"app-1","redirects":[
+				{"redirectId":"red-1","regex":"^other$","replacement":"/other"},
+				{"redirectId":"red-2","regex":"^old$","replacement":"/new","permanent":true}
+			]}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	r := NewRedirectResource()
+	testConfigureResource(t, r, server.URL)
+	model := testRedirectModel("")
+	model.ID = types.StringUnknown()
+	model.Replacement = types.StringValue("/new")
+	model.Permanent = types.BoolValue(true)
+	plan := testResourceState(t, r, model)
+
+	resp := &resource.CreateResponse{State: testResourceState(t, r, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}, Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if payload["applicationId"] != "app-1" || payload["regex"] != "^old$" || payload["replacement"] != "/new" || payload["permanent"] != true {
+		t.Fatalf("unexpected payload: %#v", payload)
+	}
+
+	var state RedirectResourceModel
+	if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("reading state: %v", diags)
+	}
+	if state.ID.ValueString() != "red-2" {
+		t.Fatalf("expected the created redirect to be resolved, got %q", state.ID.ValueString())
+	}
+}
+
+func TestRedirectUpdate_SendsPayload(t *testing.T) {
+	var payload map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/redirects.update" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		_, _ = w.Write([]byte(`true`))
+	}))
+	defer server.Close()
+
+	r := NewRedirectResource()
+	testConfigureResource(t, r, server.URL)
+	state := testResourceState(t, r, testRedirectModel("red-1"))
+	model := testRedirectModel("red-1")
+	model.Replacement = types.StringValue("/new")
+	plan := testResourceState(t, r, model)
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), resource.UpdateRequest{Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}, Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if payload["redirectId"] != "red-1" || payload["regex"] != "^old$" || payload["replacement"] != "/new" || payload["permanent"] != false {
+		t.Fatalf("unexpected payload: %#v", payload)
+	}
+}
+
+func TestRedirectImportState(t *testing.T) {
+	tests := []struct {
+		id        string
+		wantError bool
+	}{
+		{id: "app-1/red-1"},
+		{id: "red-1", wantError: true},
+		{id: "/red-1", wantError: true},
+		{id: "app-1/red-1/extra", wantError: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.id, func(t *testing.T) {
+			id, applicationID, diags := testImportState(t, NewRedirectResource(), tc.id)
+			if diags.HasError() != tc.wantError {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !tc.wantError && (id.ValueString() != "red-1" || applicationID.ValueString() != "app-1") {
+				t.Fatalf("unexpected import: id=%v application_id=%v", id, applicationID)
+			}
+		})
+	}
+}
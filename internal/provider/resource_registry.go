@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ resource.Resource = &RegistryResource{}
+var _ resource.ResourceWithImportState = &RegistryResource{}
+
+func NewRegistryResource() resource.Resource {
+	return &RegistryResource{}
+}
+
+type RegistryResource struct {
+	client *client.DokployClient
+}
+
+type RegistryResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	RegistryURL types.String `tfsdk:"registry_url"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	ImagePrefix types.String `tfsdk:"image_prefix"`
+	ServerID    types.String `tfsdk:"server_id"`
+	TestOnApply types.Bool   `tfsdk:"test_on_apply"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *RegistryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_registry"
+}
+
+func (r *RegistryResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a container registry in Dokploy. Applications reference it with registry_id to pull private images and to push images built for remote servers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"registry_url": schema.StringAttribute{
+				Required:    true,
+				Description: "Registry host, e.g. ghcr.io or registry.example.com.",
+			},
+			"username": schema.StringAttribute{
+				Required: true,
+			},
+			"password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"image_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Namespace prepended to images pushed to the registry, e.g. my-org.",
+			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the dokploy_server the registry login is configured on. Defaults to the Dokploy host.",
+			},
+			"test_on_apply": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, Dokploy logs in to the registry with the configured credentials before they are saved, and the apply fails if the login fails.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *RegistryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *RegistryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RegistryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	registry := registryFromPlan(plan)
+	if plan.TestOnApply.ValueBool() {
+		if err := r.client.TestRegistry(ctx, registry); err != nil {
+			resp.Diagnostics.AddError("Registry login failed", err.Error())
+			return
+		}
+	}
+
+	created, err := r.client.CreateRegistry(ctx, registry)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating registry", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RegistryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RegistryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	registry, err := r.client.GetRegistry(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading registry", err.Error())
+		return
+	}
+
+	state.applyRegistry(registry)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RegistryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RegistryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	registry := registryFromPlan(plan)
+	registry.ID = plan.ID.ValueString()
+	if plan.TestOnApply.ValueBool() {
+		if err := r.client.TestRegistry(ctx, registry); err != nil {
+			resp.Diagnostics.AddError("Registry login failed", err.Error())
+			return
+		}
+	}
+
+	if _, err := r.client.UpdateRegistry(ctx, registry); err != nil {
+		resp.Diagnostics.AddError("Error updating registry", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RegistryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RegistryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteRegistry(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting registry", err.Error())
+		return
+	}
+}
+
+func (r *RegistryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func registryFromPlan(plan RegistryResourceModel) client.Registry {
+	return client.Registry{
+		Name:        plan.Name.ValueString(),
+		RegistryURL: plan.RegistryURL.ValueString(),
+		Username:    plan.Username.ValueString(),
+		Password:    plan.Password.ValueString(),
+		ImagePrefix: optionalStringFromPlan(plan.ImagePrefix),
+		ServerID:    optionalStringFromPlan(plan.ServerID),
+	}
+}
+
+func (m *RegistryResourceModel) applyRegistry(registry *client.Registry) {
+	m.Name = types.StringValue(registry.Name)
+	m.RegistryURL = types.StringValue(registry.RegistryURL)
+	m.Username = types.StringValue(registry.Username)
+	// Keep the configured password when Dokploy does not return it.
+	if registry.Password != "" {
+		m.Password = types.StringValue(registry.Password)
+	}
+	if registry.ImagePrefix != "" {
+		m.ImagePrefix = types.StringValue(registry.ImagePrefix)
+	} else {
+		m.ImagePrefix = types.StringNull()
+	}
+	if registry.ServerID != "" {
+		m.ServerID = types.StringValue(registry.ServerID)
+	} else {
+		m.ServerID = types.StringNull()
+	}
+}
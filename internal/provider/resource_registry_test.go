@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func TestRegistryFromPlan_OmitsEmptyOptionals(t *testing.T) {
+	registry := registryFromPlan(RegistryResourceModel{
+		Name:        types.StringValue("ghcr"),
+		RegistryURL: types.StringValue("ghcr.io"),
+		Username:    types.StringValue("bot"),
+		Password:    types.StringValue("secret"),
+		ImagePrefix: types.StringNull(),
+		ServerID:    types.StringValue(""),
+	})
+
+	if registry.Name != "ghcr" || registry.RegistryURL != "ghcr.io" || registry.Password != "secret" {
+		t.Fatalf("unexpected registry: %+v", registry)
+	}
+	if registry.ImagePrefix != "" || registry.ServerID != "" {
+		t.Fatalf("expected empty optionals, got %+v", registry)
+	}
+}
+
+func TestApplyRegistry_KeepsPasswordWhenNotReturned(t *testing.T) {
+	model := RegistryResourceModel{
+		Password:    types.StringValue("secret"),
+		ImagePrefix: types.StringValue("acme"),
+	}
+	model.applyRegistry(&client.Registry{Name: "ghcr", RegistryURL: "ghcr.io", Username: "bot"})
+
+	if model.Password.ValueString() != "secret" {
+		t.Fatalf("password was overwritten: %q", model.Password.ValueString())
+	}
+	if !model.ImagePrefix.IsNull() || !model.ServerID.IsNull() {
+		t.Fatalf("expected cleared optionals, got image_prefix=%v server_id=%v", model.ImagePrefix, model.ServerID)
+	}
+}
+
+func TestValidateApplicationRegistry(t *testing.T) {
+	tests := []struct {
+		name       string
+		registryID types.String
+		field      string
+		value      types.String
+		wantError  bool
+	}{
+		{"registry only", types.StringValue("reg-1"), "", types.StringNull(), false},
+		{"registry and username", types.StringValue("reg-1"), "username", types.StringValue("bot"), true},
+		{"registry and registry_url", types.StringValue("reg-1"), "registry_url", types.StringValue("ghcr.io"), true},
+		{"registry and password_wo", types.StringValue("reg-1"), "password_wo", types.StringValue("secret"), true},
+		{"registry and unknown username", types.StringValue("reg-1"), "username", types.StringUnknown(), false},
+		{"unknown registry", types.StringUnknown(), "username", types.StringValue("bot"), false},
+		{"inline credentials only", types.StringNull(), "username", types.StringValue("bot"), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := ApplicationResourceModel{
+				RegistryID:  tc.registryID,
+				RegistryURL: types.StringNull(),
+				Username:    types.StringNull(),
+				Password:    types.StringNull(),
+				PasswordWO:  types.StringNull(),
+			}
+			switch tc.field {
+			case "username":
+				config.Username = tc.value
+			case "registry_url":
+				config.RegistryURL = tc.value
+			case "password_wo":
+				config.PasswordWO = tc.value
+			}
+
+			diags := validateApplicationRegistry(config)
+			if diags.HasError() != tc.wantError {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !tc.wantError {
+				return
+			}
+			if withPath, ok := diags[0].(diag.DiagnosticWithPath); !ok || !withPath.Path().Equal(path.Root(tc.field)) {
+				t.Fatalf("expected the error on %s, got %v", tc.field, diags)
+			}
+		})
+	}
+}
+
+func TestRegistryIDForUpdate(t *testing.T) {
+	if got := registryIDForUpdate(types.StringValue("reg-2"), types.StringValue("reg-1")); got == nil || *got != "reg-2" {
+		t.Fatalf("expected reg-2, got %v", got)
+	}
+	if got := registryIDForUpdate(types.StringNull(), types.StringValue("reg-1")); got == nil || *got != "" {
+		t.Fatalf("expected an empty ID to detach the registry, got %v", got)
+	}
+	if got := registryIDForUpdate(types.StringNull(), types.StringNull()); got != nil {
+		t.Fatalf("expected nil when no registry was ever set, got %q", *got)
+	}
+}
@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+const defaultServerSetupPollInterval = 10 * time.Second
+
+var _ resource.Resource = &ServerResource{}
+var _ resource.ResourceWithImportState = &ServerResource{}
+var _ resource.ResourceWithValidateConfig = &ServerResource{}
+
+var serverTypes = []string{"deploy", "build"}
+
+func NewServerResource() resource.Resource {
+	return &ServerResource{}
+}
+
+type ServerResource struct {
+	client *client.DokployClient
+}
+
+type ServerResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	IPAddress     types.String `tfsdk:"ip_address"`
+	Port          types.Int64  `tfsdk:"port"`
+	Username      types.String `tfsdk:"username"`
+	SSHKeyID      types.String `tfsdk:"ssh_key_id"`
+	ServerType    types.String `tfsdk:"server_type"`
+	SetupOnCreate types.Bool   `tfsdk:"setup_on_create"`
+	ServerStatus  types.String `tfsdk:"server_status"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ServerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+func (r *ServerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Registers a remote server that Dokploy manages over SSH. Applications, compose stacks and databases can be pinned to it with server_id.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"ip_address": schema.StringAttribute{
+				Required:    true,
+				Description: "IP address or hostname Dokploy connects to over SSH.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(22),
+				Description: "SSH port. Defaults to 22.",
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("root"),
+				Description: "SSH user. Defaults to root.",
+			},
+			"ssh_key_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the dokploy_ssh_key used to connect to the server.",
+			},
+			"server_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("deploy"),
+				Description: "Role of the server. Supported values: deploy, build. Changing it forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"setup_on_create": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, runs Dokploy's server setup (Docker, Swarm, Traefik, build tools) after creating the server and waits until it reports ready.",
+			},
+			"server_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Server status reported by Dokploy (active, inactive).",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ServerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *ServerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ServerResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateServer(config)...)
+}
+
+func (r *ServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ServerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	server := serverFromPlan(plan)
+
+	created, err := r.client.CreateServer(ctx, server)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating server", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	plan.ServerStatus = types.StringValue(created.ServerStatus)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SetupOnCreate.IsNull() && plan.SetupOnCreate.ValueBool() {
+		// The state is kept so a failed setup taints the server and the next
+		// apply starts over with a fresh registration.
+		if err := r.setupServer(ctx, created.ID, server.ServerType); err != nil {
+			resp.Diagnostics.AddError("Error setting up server", err.Error())
+		}
+	}
+}
+
+func (r *ServerResource) setupServer(ctx context.Context, id, serverType string) error {
+	if err := r.client.SetupServer(ctx, id); err != nil {
+		return err
+	}
+
+	validation, err := r.client.WaitForServerSetup(ctx, id, serverType, defaultServerSetupPollInterval)
+	if err != nil {
+		if validation != nil {
+			return fmt.Errorf("server %s did not become ready (missing: %s): %w", id, strings.Join(validation.Missing(serverType), ", "), err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *ServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ServerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	server, err := r.client.GetServer(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading server", err.Error())
+		return
+	}
+
+	state.applyServer(server)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ServerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	server := serverFromPlan(plan)
+	server.ID = plan.ID.ValueString()
+
+	updated, err := r.client.UpdateServer(ctx, server)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating server", err.Error())
+		return
+	}
+
+	plan.ServerStatus = types.StringValue(updated.ServerStatus)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ServerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteServer(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting server", err.Error())
+		return
+	}
+}
+
+func (r *ServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// validateServer checks server_type. Unknown values are skipped.
+func validateServer(config ServerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !config.ServerType.IsNull() && !config.ServerType.IsUnknown() && !containsString(serverTypes, config.ServerType.ValueString()) {
+		diags.AddAttributeError(path.Root("server_type"), "Invalid Server Configuration",
+			fmt.Sprintf("server_type must be one of %s, got %q.", strings.Join(serverTypes, ", "), config.ServerType.ValueString()))
+	}
+
+	return diags
+}
+
+func serverFromPlan(plan ServerResourceModel) client.Server {
+	return client.Server{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		IPAddress:   plan.IPAddress.ValueString(),
+		Port:        plan.Port.ValueInt64(),
+		Username:    plan.Username.ValueString(),
+		SSHKeyID:    plan.SSHKeyID.ValueString(),
+		ServerType:  plan.ServerType.ValueString(),
+	}
+}
+
+func (m *ServerResourceModel) applyServer(server *client.Server) {
+	m.Name = types.StringValue(server.Name)
+	if server.Description != "" || !m.Description.IsNull() {
+		m.Description = types.StringValue(server.Description)
+	}
+	m.IPAddress = types.StringValue(server.IPAddress)
+	if server.Port != 0 {
+		m.Port = types.Int64Value(server.Port)
+	}
+	if server.Username != "" {
+		m.Username = types.StringValue(server.Username)
+	}
+	m.SSHKeyID = types.StringValue(server.SSHKeyID)
+	if server.ServerType != "" {
+		m.ServerType = types.StringValue(server.ServerType)
+	}
+	m.ServerStatus = types.StringValue(server.ServerStatus)
+}
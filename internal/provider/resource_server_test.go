@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServerFromPlan(t *testing.T) {
+	plan := ServerResourceModel{
+		Name:       types.StringValue("worker-1"),
+		IPAddress:  types.StringValue("10.0.0.5"),
+		Port:       types.Int64Value(22),
+		Username:   types.StringValue("root"),
+		SSHKeyID:   types.StringValue("key-1"),
+		ServerType: types.StringValue("build"),
+	}
+
+	server := serverFromPlan(plan)
+	if server.ServerType != "build" {
+		t.Fatalf("unexpected server type: got %q want %q", server.ServerType, "build")
+	}
+	if server.IPAddress != "10.0.0.5" || server.SSHKeyID != "key-1" || server.Port != 22 {
+		t.Fatalf("unexpected server: %+v", server)
+	}
+}
+
+func TestValidateServer(t *testing.T) {
+	tests := []struct {
+		serverType types.String
+		wantError  bool
+	}{
+		{types.StringValue("deploy"), false},
+		{types.StringValue("build"), false},
+		{types.StringUnknown(), false},
+		{types.StringValue("Build"), true},
+		{types.StringValue("edge"), true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.serverType.String(), func(t *testing.T) {
+			diags := validateServer(ServerResourceModel{ServerType: tc.serverType})
+			if diags.HasError() != tc.wantError {
+				t.Fatalf("expected error %v, got %v", tc.wantError, diags)
+			}
+			if tc.wantError {
+				if d, ok := diags[0].(diag.DiagnosticWithPath); !ok || !d.Path().Equal(path.Root("server_type")) {
+					t.Fatalf("expected a server_type diagnostic, got %v", diags)
+				}
+			}
+		})
+	}
+}
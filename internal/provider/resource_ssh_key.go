@@ -3,8 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &SSHKeyResource{}
 var _ resource.ResourceWithImportState = &SSHKeyResource{}
+var _ resource.ResourceWithValidateConfig = &SSHKeyResource{}
 
 func NewSSHKeyResource() resource.Resource {
 	return &SSHKeyResource{}
@@ -31,13 +32,18 @@ type SSHKeyResourceModel struct {
 	Description types.String `tfsdk:"description"`
 	PrivateKey  types.String `tfsdk:"private_key"`
 	PublicKey   types.String `tfsdk:"public_key"`
+
+	PrivateKeyWO        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion types.Int64  `tfsdk:"private_key_wo_version"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *SSHKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_ssh_key"
 }
 
-func (r *SSHKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *SSHKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -50,12 +56,15 @@ func (r *SSHKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional: true,
 			},
 			"private_key": schema.StringAttribute{
-				Required:  true,
-				Sensitive: true,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Private key in OpenSSH format. Exactly one of private_key and private_key_wo must be set. Dokploy cannot update SSH keys, so switching between private_key and private_key_wo, or changing private_key_wo_version, replaces the key. Dokploy does not return the key, so an imported key is only replaced once it changes after the first apply.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					replaceIfPriorKnown(),
 				},
 			},
+			"private_key_wo":         writeOnlyStringAttribute("private_key"),
+			"private_key_wo_version": writeOnlyVersionAttribute("private_key", replaceInt64IfPriorKnown()),
 			"public_key": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -63,6 +72,9 @@ func (r *SSHKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -78,6 +90,17 @@ func (r *SSHKeyResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+func (r *SSHKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SSHKeyResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateWriteOnlyPair(config.PrivateKey, config.PrivateKeyWO, config.PrivateKeyWOVersion, "private_key", true)...)
+}
+
 func (r *SSHKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan SSHKeyResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -86,10 +109,25 @@ func (r *SSHKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	privateKey, diags := secretFromConfig(ctx, req.Config, plan.PrivateKey, "private_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	key, err := r.client.CreateSSHKey(
+		ctx,
 		plan.Name.ValueString(),
 		plan.Description.ValueString(),
-		plan.PrivateKey.ValueString(),
+		privateKey,
 		plan.PublicKey.ValueString(),
 	)
 	if err != nil {
@@ -111,9 +149,17 @@ func (r *SSHKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	key, err := r.client.GetSSHKey(state.ID.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	key, err := r.client.GetSSHKey(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -137,7 +183,17 @@ func (r *SSHKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Keys usually force replace if content changes.
 	// We marked key fields as RequiresReplace.
 	// Name/Description updates might be supported via API but usually minor.
-	// Assuming no update for now.
+	// Assuming no update for now; only Terraform-side settings such as
+	// timeouts can change here.
+	var plan SSHKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *SSHKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -148,9 +204,17 @@ func (r *SSHKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteSSHKey(state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteSSHKey(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError("Error deleting SSH Key", err.Error())
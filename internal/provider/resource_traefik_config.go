@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,13 +34,15 @@ type TraefikConfigResourceModel struct {
 	ServerID      types.String `tfsdk:"server_id"`
 	Config        types.String `tfsdk:"config"`
 	ReloadOnApply types.Bool   `tfsdk:"reload_on_apply"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *TraefikConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_traefik_config"
 }
 
-func (r *TraefikConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *TraefikConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages Dokploy global Traefik configuration via settings.read/update/reloadTraefikConfig endpoints.",
 		Attributes: map[string]schema.Attribute{
@@ -76,6 +79,9 @@ func (r *TraefikConfigResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "If true, triggers settings.reloadTraefik after create/update.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -100,6 +106,14 @@ func (r *TraefikConfigResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	scope, err := normalizeTraefikConfigScopeForResource(plan.Scope)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid scope", err.Error())
@@ -107,13 +121,13 @@ func (r *TraefikConfigResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	serverID := optionalStringPointer(plan.ServerID)
-	if err := r.client.UpdateScopedTraefikConfig(scope, serverID, plan.Config.ValueString()); err != nil {
+	if err := r.client.UpdateScopedTraefikConfig(ctx, scope, serverID, plan.Config.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error creating Traefik config", err.Error())
 		return
 	}
 
 	if plan.ReloadOnApply.ValueBool() {
-		if err := r.client.ReloadTraefik(serverID); err != nil {
+		if err := r.client.ReloadTraefik(ctx, serverID); err != nil {
 			resp.Diagnostics.AddError("Error reloading Traefik", err.Error())
 			return
 		}
@@ -131,15 +145,23 @@ func (r *TraefikConfigResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	scope, err := normalizeTraefikConfigScopeForResource(state.Scope)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid scope", err.Error())
 		return
 	}
 
-	config, err := r.client.ReadScopedTraefikConfig(scope, optionalStringPointer(state.ServerID))
+	config, err := r.client.ReadScopedTraefikConfig(ctx, scope, optionalStringPointer(state.ServerID))
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -160,6 +182,14 @@ func (r *TraefikConfigResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	scope, err := normalizeTraefikConfigScopeForResource(plan.Scope)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid scope", err.Error())
@@ -167,13 +197,13 @@ func (r *TraefikConfigResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	serverID := optionalStringPointer(plan.ServerID)
-	if err := r.client.UpdateScopedTraefikConfig(scope, serverID, plan.Config.ValueString()); err != nil {
+	if err := r.client.UpdateScopedTraefikConfig(ctx, scope, serverID, plan.Config.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error updating Traefik config", err.Error())
 		return
 	}
 
 	if plan.ReloadOnApply.ValueBool() {
-		if err := r.client.ReloadTraefik(serverID); err != nil {
+		if err := r.client.ReloadTraefik(ctx, serverID); err != nil {
 			resp.Diagnostics.AddError("Error reloading Traefik", err.Error())
 			return
 		}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -30,6 +31,7 @@ type VolumeBackupResource struct {
 type VolumeBackupResourceModel struct {
 	ID              types.String `tfsdk:"id"`
 	Name            types.String `tfsdk:"name"`
+	ApplicationID   types.String `tfsdk:"application_id"`
 	ComposeID       types.String `tfsdk:"compose_id"`
 	AppName         types.String `tfsdk:"app_name"`
 	ServiceName     types.String `tfsdk:"service_name"`
@@ -40,15 +42,17 @@ type VolumeBackupResourceModel struct {
 	Prefix          types.String `tfsdk:"prefix"`
 	KeepLatestCount types.Int64  `tfsdk:"keep_latest_count"`
 	Enabled         types.Bool   `tfsdk:"enabled"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *VolumeBackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_volume_backup"
 }
 
-func (r *VolumeBackupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *VolumeBackupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a Dokploy volume backup for a compose service volume.",
+		Description: "Manages a Dokploy volume backup for a named volume of an application or a compose service.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -59,8 +63,16 @@ func (r *VolumeBackupResource) Schema(_ context.Context, _ resource.SchemaReques
 			"name": schema.StringAttribute{
 				Required: true,
 			},
+			"application_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Application whose volume is backed up. Exactly one of application_id or compose_id must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"compose_id": schema.StringAttribute{
-				Required: true,
+				Optional:    true,
+				Description: "Compose stack whose volume is backed up. Exactly one of application_id or compose_id must be set.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -68,16 +80,18 @@ func (r *VolumeBackupResource) Schema(_ context.Context, _ resource.SchemaReques
 			"app_name": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "Compose app name used by Dokploy to resolve concrete volume names. If omitted, it is resolved from compose_id.",
+				Description: "App name used by Dokploy to resolve concrete volume names. If omitted, it is resolved from application_id or compose_id.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"service_name": schema.StringAttribute{
-				Required: true,
+				Optional:    true,
+				Description: "Compose service that mounts the volume. Required with compose_id, not used with application_id.",
 			},
 			"volume_name": schema.StringAttribute{
-				Required: true,
+				Required:    true,
+				Description: "Volume to back up. Compose volume names are prefixed with the app name automatically; application volumes are used as named in the mount.",
 			},
 			"destination_id": schema.StringAttribute{
 				Optional:    true,
@@ -120,6 +134,9 @@ func (r *VolumeBackupResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "Whether the backup schedule is enabled. Defaults to true.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -143,27 +160,41 @@ func (r *VolumeBackupResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	applyVolumeBackupDefaults(&plan)
-	resolvedAppName, err := r.resolveAppName(plan.AppName, plan.ComposeID)
+	serviceType, targetID, err := volumeBackupTarget(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid volume backup target", err.Error())
+		return
+	}
+	resolvedAppName, err := r.resolveAppName(ctx, plan.AppName, serviceType, targetID)
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid compose backup configuration", err.Error())
+		resp.Diagnostics.AddError("Invalid volume backup configuration", err.Error())
 		return
 	}
 	plan.AppName = types.StringValue(resolvedAppName)
 
-	destinationID, err := r.resolveDestinationID(plan.DestinationID, plan.DestinationName)
+	destinationID, err := resolveDestinationID(ctx, r.client, plan.DestinationID, plan.DestinationName)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid backup destination configuration", err.Error())
 		return
 	}
 
-	created, err := r.client.CreateVolumeBackup(client.VolumeBackup{
+	created, err := r.client.CreateVolumeBackup(ctx, client.VolumeBackup{
 		Name:            plan.Name.ValueString(),
-		ServiceType:     "compose",
+		ServiceType:     serviceType,
+		ApplicationID:   plan.ApplicationID.ValueString(),
 		ComposeID:       plan.ComposeID.ValueString(),
 		AppName:         plan.AppName.ValueString(),
 		ServiceName:     plan.ServiceName.ValueString(),
-		VolumeName:      resolveComposeVolumeName(plan.AppName.ValueString(), plan.VolumeName.ValueString()),
+		VolumeName:      resolveVolumeBackupVolumeName(serviceType, plan.AppName.ValueString(), plan.VolumeName.ValueString()),
 		DestinationID:   destinationID,
 		CronExpression:  plan.CronExpression.ValueString(),
 		Prefix:          plan.Prefix.ValueString(),
@@ -197,9 +228,17 @@ func (r *VolumeBackupResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	backup, err := r.client.GetVolumeBackup(state.ID.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	backup, err := r.client.GetVolumeBackup(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -224,28 +263,42 @@ func (r *VolumeBackupResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	applyVolumeBackupDefaults(&plan)
-	resolvedAppName, err := r.resolveAppName(plan.AppName, plan.ComposeID)
+	serviceType, targetID, err := volumeBackupTarget(plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid compose backup configuration", err.Error())
+		resp.Diagnostics.AddError("Invalid volume backup target", err.Error())
+		return
+	}
+	resolvedAppName, err := r.resolveAppName(ctx, plan.AppName, serviceType, targetID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid volume backup configuration", err.Error())
 		return
 	}
 	plan.AppName = types.StringValue(resolvedAppName)
 
-	destinationID, err := r.resolveDestinationID(plan.DestinationID, plan.DestinationName)
+	destinationID, err := resolveDestinationID(ctx, r.client, plan.DestinationID, plan.DestinationName)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid backup destination configuration", err.Error())
 		return
 	}
 
-	updated, err := r.client.UpdateVolumeBackup(client.VolumeBackup{
+	updated, err := r.client.UpdateVolumeBackup(ctx, client.VolumeBackup{
 		ID:              plan.ID.ValueString(),
 		Name:            plan.Name.ValueString(),
-		ServiceType:     "compose",
+		ServiceType:     serviceType,
+		ApplicationID:   plan.ApplicationID.ValueString(),
 		ComposeID:       plan.ComposeID.ValueString(),
 		AppName:         plan.AppName.ValueString(),
 		ServiceName:     plan.ServiceName.ValueString(),
-		VolumeName:      resolveComposeVolumeName(plan.AppName.ValueString(), plan.VolumeName.ValueString()),
+		VolumeName:      resolveVolumeBackupVolumeName(serviceType, plan.AppName.ValueString(), plan.VolumeName.ValueString()),
 		DestinationID:   destinationID,
 		CronExpression:  plan.CronExpression.ValueString(),
 		Prefix:          plan.Prefix.ValueString(),
@@ -273,9 +326,17 @@ func (r *VolumeBackupResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	err := r.client.DeleteVolumeBackup(state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteVolumeBackup(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError("Error deleting volume backup", err.Error())
@@ -319,6 +380,9 @@ func applyVolumeBackupState(state VolumeBackupResourceModel, backup *client.Volu
 	if strings.TrimSpace(backup.Name) != "" {
 		state.Name = types.StringValue(backup.Name)
 	}
+	if strings.TrimSpace(backup.ApplicationID) != "" {
+		state.ApplicationID = types.StringValue(backup.ApplicationID)
+	}
 	if strings.TrimSpace(backup.ComposeID) != "" {
 		state.ComposeID = types.StringValue(backup.ComposeID)
 	}
@@ -329,7 +393,11 @@ func applyVolumeBackupState(state VolumeBackupResourceModel, backup *client.Volu
 		state.ServiceName = types.StringValue(backup.ServiceName)
 	}
 	if strings.TrimSpace(backup.VolumeName) != "" {
-		state.VolumeName = types.StringValue(stripComposeVolumePrefix(state.AppName.ValueString(), backup.VolumeName))
+		if isApplicationVolumeBackup(state, backup) {
+			state.VolumeName = types.StringValue(strings.TrimSpace(backup.VolumeName))
+		} else {
+			state.VolumeName = types.StringValue(stripComposeVolumePrefix(state.AppName.ValueString(), backup.VolumeName))
+		}
 	}
 	if strings.TrimSpace(backup.DestinationID) != "" {
 		state.DestinationID = types.StringValue(backup.DestinationID)
@@ -358,13 +426,15 @@ func applyVolumeBackupState(state VolumeBackupResourceModel, backup *client.Volu
 	return state
 }
 
-func (r *VolumeBackupResource) resolveDestinationID(destinationID, destinationName types.String) (string, error) {
+// resolveDestinationID returns destination_id when set, otherwise the ID of the
+// destination named by destination_name.
+func resolveDestinationID(ctx context.Context, c *client.DokployClient, destinationID, destinationName types.String) (string, error) {
 	if !destinationID.IsNull() && !destinationID.IsUnknown() && strings.TrimSpace(destinationID.ValueString()) != "" {
 		return strings.TrimSpace(destinationID.ValueString()), nil
 	}
 
 	if !destinationName.IsNull() && !destinationName.IsUnknown() && strings.TrimSpace(destinationName.ValueString()) != "" {
-		destination, err := r.client.FindBackupDestinationByName(destinationName.ValueString())
+		destination, err := c.FindBackupDestinationByName(ctx, destinationName.ValueString())
 		if err != nil {
 			return "", err
 		}
@@ -374,30 +444,69 @@ func (r *VolumeBackupResource) resolveDestinationID(destinationID, destinationNa
 	return "", fmt.Errorf("set either destination_id or destination_name")
 }
 
-func (r *VolumeBackupResource) resolveAppName(appName, composeID types.String) (string, error) {
+// volumeBackupTarget returns the service type ("application" or "compose")
+// and ID the backup is attached to.
+func volumeBackupTarget(plan VolumeBackupResourceModel) (string, string, error) {
+	serviceType, targetID, err := getEnvironmentVariableTarget(plan.ApplicationID, plan.ComposeID)
+	if err != nil {
+		return "", "", err
+	}
+	if serviceType == "compose" && strings.TrimSpace(plan.ServiceName.ValueString()) == "" {
+		return "", "", fmt.Errorf("service_name is required when compose_id is set")
+	}
+	return serviceType, strings.TrimSpace(targetID), nil
+}
+
+func (r *VolumeBackupResource) resolveAppName(ctx context.Context, appName types.String, serviceType, targetID string) (string, error) {
 	if !appName.IsNull() && !appName.IsUnknown() && strings.TrimSpace(appName.ValueString()) != "" {
 		return strings.TrimSpace(appName.ValueString()), nil
 	}
 
-	if composeID.IsNull() || composeID.IsUnknown() || strings.TrimSpace(composeID.ValueString()) == "" {
-		return "", fmt.Errorf("compose_id is required to resolve app_name")
+	var resolvedAppName, resolvedName string
+	switch serviceType {
+	case "application":
+		app, err := r.client.GetApplication(ctx, targetID)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve application app_name from application_id: %w", err)
+		}
+		if app != nil {
+			resolvedAppName, resolvedName = app.AppName, app.Name
+		}
+	default:
+		comp, err := r.client.GetCompose(ctx, targetID)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve compose app_name from compose_id: %w", err)
+		}
+		if comp != nil {
+			resolvedAppName, resolvedName = comp.AppName, comp.Name
+		}
 	}
 
-	comp, err := r.client.GetCompose(strings.TrimSpace(composeID.ValueString()))
-	if err != nil {
-		return "", fmt.Errorf("failed to resolve compose app_name from compose_id: %w", err)
+	if strings.TrimSpace(resolvedAppName) != "" {
+		return strings.TrimSpace(resolvedAppName), nil
+	}
+	if strings.TrimSpace(resolvedName) != "" {
+		return strings.TrimSpace(resolvedName), nil
 	}
 
-	if comp != nil {
-		if strings.TrimSpace(comp.AppName) != "" {
-			return strings.TrimSpace(comp.AppName), nil
-		}
-		if strings.TrimSpace(comp.Name) != "" {
-			return strings.TrimSpace(comp.Name), nil
-		}
+	return "", fmt.Errorf("%s %s did not return app_name", serviceType, targetID)
+}
+
+// resolveVolumeBackupVolumeName returns the Docker volume name Dokploy backs
+// up. Compose projects prefix their volumes with the app name, while
+// application mounts use the volume name as configured.
+func resolveVolumeBackupVolumeName(serviceType, appName, volumeName string) string {
+	if serviceType == "application" {
+		return strings.TrimSpace(volumeName)
 	}
+	return resolveComposeVolumeName(appName, volumeName)
+}
 
-	return "", fmt.Errorf("compose %s did not return app_name", strings.TrimSpace(composeID.ValueString()))
+func isApplicationVolumeBackup(state VolumeBackupResourceModel, backup *client.VolumeBackup) bool {
+	if backup.ServiceType != "" {
+		return backup.ServiceType == "application"
+	}
+	return strings.TrimSpace(backup.ApplicationID) != "" || (!state.ApplicationID.IsNull() && state.ApplicationID.ValueString() != "")
 }
 
 func resolveComposeVolumeName(appName, volumeName string) string {
@@ -1,6 +1,11 @@
 package provider
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
 
 func TestResolveComposeVolumeName(t *testing.T) {
 	tests := []struct {
@@ -45,3 +50,52 @@ func TestStripComposeVolumePrefix(t *testing.T) {
 		t.Fatalf("unexpected value: got %q want %q", got, "ghost-mysql-data")
 	}
 }
+
+func TestResolveVolumeBackupVolumeName_ApplicationKeepsName(t *testing.T) {
+	if got := resolveVolumeBackupVolumeName("application", "api-x1y2z3", "uploads"); got != "uploads" {
+		t.Fatalf("unexpected value: got %q want %q", got, "uploads")
+	}
+	if got := resolveVolumeBackupVolumeName("compose", "ghost-6bj1z0", "ghost-mysql-data"); got != "ghost-6bj1z0_ghost-mysql-data" {
+		t.Fatalf("unexpected value: got %q want %q", got, "ghost-6bj1z0_ghost-mysql-data")
+	}
+}
+
+func TestVolumeBackupTarget(t *testing.T) {
+	serviceType, id, err := volumeBackupTarget(VolumeBackupResourceModel{
+		ApplicationID: types.StringValue("app-1"),
+		ComposeID:     types.StringNull(),
+		ServiceName:   types.StringNull(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if serviceType != "application" || id != "app-1" {
+		t.Fatalf("unexpected target: %s %s", serviceType, id)
+	}
+
+	_, _, err = volumeBackupTarget(VolumeBackupResourceModel{
+		ApplicationID: types.StringNull(),
+		ComposeID:     types.StringValue("compose-1"),
+		ServiceName:   types.StringNull(),
+	})
+	if err == nil {
+		t.Fatal("expected service_name to be required for compose targets")
+	}
+}
+
+func TestApplyVolumeBackupState_ApplicationVolumeIsNotStripped(t *testing.T) {
+	state := applyVolumeBackupState(VolumeBackupResourceModel{}, &client.VolumeBackup{
+		ID:            "vb-1",
+		ServiceType:   "application",
+		ApplicationID: "app-1",
+		AppName:       "api",
+		VolumeName:    "api_uploads",
+	})
+
+	if state.ApplicationID.ValueString() != "app-1" {
+		t.Fatalf("unexpected application_id: %q", state.ApplicationID.ValueString())
+	}
+	if state.VolumeName.ValueString() != "api_uploads" {
+		t.Fatalf("unexpected volume_name: got %q want %q", state.VolumeName.ValueString(), "api_uploads")
+	}
+}
@@ -0,0 +1,13 @@
+package provider
+
+import "time"
+
+// Operation timeouts used when a resource's timeouts block leaves a value
+// unset. Individual API requests are additionally bounded by the provider's
+// request_timeout.
+const (
+	defaultCreateTimeout = 30 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 30 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Secrets can be configured either with a regular sensitive attribute, which
+// is stored in state, or with a write-only <name>_wo variant (Terraform 1.11
+// and later), which is only available in the configuration during apply.
+// Changing the companion <name>_wo_version attribute triggers the update
+// that sends a new write-only value to Dokploy. Resources that Dokploy cannot
+// update, such as SSH keys and certificates, are replaced instead, except
+// when state has no value yet because the resource was imported.
+
+// writeOnlyStringAttribute returns the write-only variant of a secret.
+func writeOnlyStringAttribute(name string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+		Description: fmt.Sprintf("Write-only variant of %s that is never stored in state. Requires Terraform 1.11 or later. Conflicts with %s.", name, name),
+	}
+}
+
+// writeOnlyMapAttribute returns the write-only variant of a map of secrets.
+func writeOnlyMapAttribute(name string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+		ElementType: types.StringType,
+		Description: fmt.Sprintf("Write-only variant of %s that is never stored in state. Requires Terraform 1.11 or later. Conflicts with %s.", name, name),
+	}
+}
+
+// writeOnlyVersionAttribute returns the version attribute that triggers
+// sending a write-only secret again.
+func writeOnlyVersionAttribute(name string, modifiers ...planmodifier.Int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:      true,
+		Description:   fmt.Sprintf("Change this value to send a new %s_wo to Dokploy.", name),
+		PlanModifiers: modifiers,
+	}
+}
+
+// replaceInt64IfPriorKnown is the int64 counterpart of replaceIfPriorKnown.
+// Imported resources have no version in state, so setting one for the first
+// time does not replace them.
+func replaceInt64IfPriorKnown() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull() && !req.StateValue.IsUnknown()
+		},
+		"Changing the value forces a new resource.",
+		"Changing the value forces a new resource.",
+	)
+}
+
+// writeOnlyString reads a write-only attribute from the configuration. Plan
+// and state always hold null for write-only attributes.
+func writeOnlyString(ctx context.Context, config tfsdk.Config, name string) (types.String, diag.Diagnostics) {
+	var value types.String
+	diags := config.GetAttribute(ctx, path.Root(name), &value)
+	return value, diags
+}
+
+// secretFromConfig returns the value of the secret attribute name, or of its
+// write-only variant when that is configured instead.
+func secretFromConfig(ctx context.Context, config tfsdk.Config, value types.String, name string) (string, diag.Diagnostics) {
+	writeOnly, diags := writeOnlyString(ctx, config, name+"_wo")
+	if !writeOnly.IsNull() && !writeOnly.IsUnknown() {
+		return writeOnly.ValueString(), diags
+	}
+	return value.ValueString(), diags
+}
+
+// validateWriteOnlyPair checks that at most one of a secret and its
+// write-only variant is configured, that one of them is when required, and
+// that the version is only set together with the write-only variant.
+func validateWriteOnlyPair(value, writeOnly, version attr.Value, name string, required bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsUnknown() || writeOnly.IsUnknown() {
+		return diags
+	}
+
+	switch {
+	case !value.IsNull() && !writeOnly.IsNull():
+		diags.AddAttributeError(path.Root(name+"_wo"), "Conflicting Secret Configuration",
+			fmt.Sprintf("Only one of %s and %s_wo can be set.", name, name))
+	case required && value.IsNull() && writeOnly.IsNull():
+		diags.AddAttributeError(path.Root(name), "Missing Secret Configuration",
+			fmt.Sprintf("One of %s or %s_wo must be set.", name, name))
+	}
+
+	if version != nil && !version.IsNull() && !version.IsUnknown() && writeOnly.IsNull() {
+		diags.AddAttributeError(path.Root(name+"_wo_version"), "Invalid Secret Configuration",
+			fmt.Sprintf("%s_wo_version can only be set together with %s_wo.", name, name))
+	}
+	return diags
+}